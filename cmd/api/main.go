@@ -2,14 +2,36 @@ package main
 
 import (
 	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/internal/audit"
+	"github.com/sebenitezg/hotel-service/internal/availability"
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/folio"
+	"github.com/sebenitezg/hotel-service/internal/guest"
 	"github.com/sebenitezg/hotel-service/internal/hotel"
+	"github.com/sebenitezg/hotel-service/internal/housekeeping"
+	"github.com/sebenitezg/hotel-service/internal/inventory"
+	"github.com/sebenitezg/hotel-service/internal/maintenance"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+	"github.com/sebenitezg/hotel-service/internal/quote"
+	"github.com/sebenitezg/hotel-service/internal/rateplan"
+	"github.com/sebenitezg/hotel-service/internal/reservation"
 	"github.com/sebenitezg/hotel-service/internal/room"
 	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/internal/stay"
+	"github.com/sebenitezg/hotel-service/internal/tax"
 	"github.com/sebenitezg/hotel-service/pkg/db"
+	"github.com/sebenitezg/hotel-service/pkg/health"
+	"github.com/sebenitezg/hotel-service/pkg/lifecycle"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	grpcserver "github.com/sebenitezg/hotel-service/pkg/server/grpc"
 	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 
+	"context"
 	"log"
+	"os"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -24,7 +46,12 @@ func main() {
 
 	// Initialize Logger
 	logger.GetLogger(configs.Server.DebugMode)
-	defer logger.CloseLogger()
+
+	// Export the spans of the requests and their queries
+	tracerProvider, err := tracing.NewProvider(configs.Tracing)
+	if err != nil {
+		log.Fatalf("Error initializing tracing: %v", err)
+	}
 
 	// Validator service
 	validatorInstance := validator.New()
@@ -35,20 +62,129 @@ func main() {
 	// Initialize HTTP Server
 	httpServer := rest.NewHTTPServer(configs.Server)
 
+	// Readiness probe checks of /readyz
+	httpServer.Health.Add("database", health.DatabasePing(database))
+	httpServer.Health.Add("database_pool", health.PoolSaturation(database))
+	if configs.Database.MigrationsDir != "" {
+		migrationCheck, err := health.MigrationVersion(database, configs.Database.MigrationsDir)
+		if err != nil {
+			log.Fatalf("Error reading database migrations: %v", err)
+		}
+		httpServer.Health.Add("database_migrations", migrationCheck)
+	}
+
+	// Every REST endpoint requires a valid bearer token
+	authenticator, err := middleware.NewAuthenticator(configs.Auth)
+	if err != nil {
+		log.Fatalf("Error initializing authenticator: %v", err)
+	}
+	httpServer.Router.Use(authenticator.Authenticate)
+
+	// Initialize gRPC Server, its calls are authenticated with the same
+	// bearer tokens
+	grpcServer := grpcserver.NewGRPCServer(configs.Server, authenticator)
+
 	// Setup Repositories
 	roomRepository := room.NewRepository(database)
 	roomTypeRepository := roomtype.NewRepository(database)
+	ratePlanRepository := rateplan.NewRepository(database)
+	taxRepository := tax.NewRepository(database)
 	hotelRepository := hotel.NewRepository(database)
+	membershipRepository := membership.NewRepository(database)
+	reservationRepository := reservation.NewRepository(database)
+	availabilityRepository := availability.NewRepository(database)
+	inventoryRepository := inventory.NewRepository(database)
+	housekeepingRepository := housekeeping.NewRepository(database)
+	maintenanceRepository := maintenance.NewRepository(database)
+	guestRepository := guest.NewRepository(database)
+	stayRepository := stay.NewRepository(database)
+	folioRepository := folio.NewRepository(database)
+	auditRepository := audit.NewRepository(database)
+	exchangeRateRepository := currency.NewRepository(database)
+	outboxRepository := outbox.NewRepository(database)
 
 	// Setup Services
-	hotelService := hotel.NewService(hotelRepository)
-	roomTypeService := roomtype.NewService(roomTypeRepository, hotelService)
+	auditService := audit.NewService(auditRepository)
+	currencyService := currency.NewService(exchangeRateRepository)
+	if configs.Currency.RatesFile != "" {
+		ratesFile := configs.Currency.RatesFile
+		if err := currencyService.LoadExchangeRatesFile(context.Background(), ratesFile, validatorInstance); err != nil {
+			log.Fatalf("Error loading exchange rates: %v", err)
+		}
+	}
+	membershipService := membership.NewService(membershipRepository)
+	hotelService := hotel.NewService(hotelRepository, membershipService)
+	roomTypeService := roomtype.NewService(roomTypeRepository, hotelService, hotelService)
 	roomService := room.NewService(roomRepository, hotelService, roomTypeService)
+	ratePlanService := rateplan.NewService(ratePlanRepository, hotelService, roomTypeService)
+	taxService := tax.NewService(taxRepository, hotelService)
+	quoteService := quote.NewService(hotelService, roomTypeService, ratePlanService, taxService, currencyService)
+	guestCipher, err := guest.NewCipher(configs.Guest.EncryptionKey)
+	if err != nil {
+		log.Fatalf("Error initializing guest encryption: %v", err)
+	}
+	guestService := guest.NewService(guestRepository, hotelService, guestCipher)
+	reservationService := reservation.NewService(
+		reservationRepository, hotelService, roomService, roomService, guestService,
+	)
+	inventoryService := inventory.NewService(inventoryRepository, hotelService, roomTypeService)
+	availabilityService := availability.NewService(availabilityRepository, roomTypeService, inventoryService)
+	housekeepingService := housekeeping.NewService(
+		housekeepingRepository, hotelService, roomTypeService, roomService, membershipService,
+	)
+
+	maintenanceService := maintenance.NewService(maintenanceRepository, roomService)
+	stayService := stay.NewService(
+		stayRepository, hotelService, roomService, roomTypeService, reservationService, guestService,
+	)
+	folioService := folio.NewService(folioRepository, hotelService, roomService, roomTypeService, guestService)
 
 	// Initialize Controllers
-	hotel.NewController(httpServer, validatorInstance, hotelService)
-	roomtype.NewController(httpServer, validatorInstance, roomTypeService)
-	room.NewController(httpServer, validatorInstance, roomService)
+	membership.NewController(httpServer, validatorInstance, membershipService)
+	hotel.NewController(httpServer, validatorInstance, hotelService, membershipService)
+	roomtype.NewController(httpServer, validatorInstance, roomTypeService, currencyService, membershipService)
+	room.NewController(httpServer, validatorInstance, roomService, membershipService)
+	rateplan.NewController(httpServer, validatorInstance, ratePlanService, membershipService)
+	tax.NewController(httpServer, validatorInstance, taxService, membershipService)
+	quote.NewController(httpServer, validatorInstance, quoteService, membershipService)
+	reservation.NewController(httpServer, validatorInstance, reservationService, membershipService)
+	availability.NewController(httpServer, availabilityService, membershipService)
+	inventory.NewController(httpServer, validatorInstance, inventoryService, membershipService)
+	housekeeping.NewController(httpServer, validatorInstance, housekeepingService, membershipService)
+	maintenance.NewController(httpServer, validatorInstance, maintenanceService, membershipService)
+	guest.NewController(httpServer, validatorInstance, guestService, membershipService)
+	stay.NewController(httpServer, validatorInstance, stayService, membershipService)
+	folio.NewController(httpServer, validatorInstance, folioService, membershipService)
+	audit.NewController(httpServer, auditService, membershipService)
+	currency.NewController(httpServer, validatorInstance, currencyService)
 
-	httpServer.Start()
+	// Initialize gRPC Controllers
+	hotel.NewGRPCController(grpcServer, hotelService, membershipService)
+	roomtype.NewGRPCController(grpcServer, roomTypeService, membershipService)
+	room.NewGRPCController(grpcServer, roomService, membershipService)
+
+	// Relay the domain events stored in the outbox
+	publisher, err := outbox.NewPublisher(configs.Outbox)
+	if err != nil {
+		log.Fatalf("Error initializing outbox publisher: %v", err)
+	}
+	relay := outbox.NewRelay(outboxRepository, publisher, configs.Outbox)
+
+	// Run until SIGINT or SIGTERM, then drain the servers and the relay
+	// before closing the database and flushing the spans and logs
+	manager := lifecycle.NewManager(configs.Server.ShutdownTimeout)
+	manager.Serve("http", httpServer.Start, httpServer.Shutdown)
+	manager.Serve("grpc", grpcServer.Start, grpcServer.Shutdown)
+	manager.Go("outbox relay", relay.Start)
+	manager.OnClose("database", database.Close)
+	manager.OnClose("tracing", tracerProvider.Close)
+	manager.OnClose("logger", func() error {
+		logger.CloseLogger()
+		return nil
+	})
+
+	if err := manager.Run(); err != nil {
+		log.Printf("Shutdown with errors: %v", err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,41 @@
+// Command migrate-guests links the reservations, stays and folios that still
+// store the name and email of their guest in clear text to guest profiles.
+// It is run once after the guest_profile_links migration, the API does not
+// link them itself.
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/internal/guest"
+	"github.com/sebenitezg/hotel-service/internal/hotel"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/db"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+)
+
+func main() {
+	configs, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading configurations: %v", err)
+	}
+
+	logger.GetLogger(configs.Server.DebugMode)
+
+	database := db.NewConnection(configs.Database)
+
+	guestCipher, err := guest.NewCipher(configs.Guest.EncryptionKey)
+	if err != nil {
+		log.Fatalf("Error initializing guest encryption: %v", err)
+	}
+
+	membershipService := membership.NewService(membership.NewRepository(database))
+	hotelService := hotel.NewService(hotel.NewRepository(database), membershipService)
+	guestService := guest.NewService(guest.NewRepository(database), hotelService, guestCipher)
+
+	if err := guestService.LinkPlaintextGuests(context.Background()); err != nil {
+		log.Fatalf("Error linking plaintext guests to guest profiles: %v", err)
+	}
+}
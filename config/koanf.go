@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"strings"
+	"time"
 
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/env"
@@ -14,21 +15,88 @@ import (
 type Configurations struct {
 	Server   ServerConfigurations   `koanf:"server"`
 	Database DatabaseConfigurations `koanf:"database"`
+	Auth     AuthConfigurations     `koanf:"auth"`
+	Outbox   OutboxConfigurations   `koanf:"outbox"`
+	Currency CurrencyConfigurations `koanf:"currency"`
+	Guest    GuestConfigurations    `koanf:"guest"`
+	Tracing  TracingConfigurations  `koanf:"tracing"`
 }
 
+// ServerConfigurations ShutdownTimeout bounds how long in-flight requests
+// and background workers are waited for on SIGINT or SIGTERM, 30s when unset.
+// DrainDelay is how long the http server keeps serving with a failing
+// readiness probe before it stops accepting connections.
 type ServerConfigurations struct {
-	Port      string `koanf:"port"`
-	DebugMode bool   `koanf:"debug-mode"`
+	Port            string        `koanf:"port"`
+	GRPCPort        string        `koanf:"grpc-port"`
+	DebugMode       bool          `koanf:"debug-mode"`
+	ShutdownTimeout time.Duration `koanf:"shutdown-timeout"`
+	DrainDelay      time.Duration `koanf:"drain-delay"`
 }
 
+// DatabaseConfigurations MigrationsDir holds the dbmate migrations the
+// schema must be up to date with for the service to be ready, the check is
+// skipped when empty
 type DatabaseConfigurations struct {
-	Host       string `koanf:"host"`
-	Port       int    `koanf:"port"`
-	DbName     string `koanf:"db-name"`
-	Username   string `koanf:"user"`
-	Password   string `koanf:"password"`
-	PoolSize   int    `koanf:"pool-size"`
-	LogQueries bool   `koanf:"log-queries"`
+	Host          string `koanf:"host"`
+	Port          int    `koanf:"port"`
+	DbName        string `koanf:"db-name"`
+	Username      string `koanf:"user"`
+	Password      string `koanf:"password"`
+	PoolSize      int    `koanf:"pool-size"`
+	LogQueries    bool   `koanf:"log-queries"`
+	MigrationsDir string `koanf:"migrations-dir"`
+}
+
+// AuthConfigurations Keys used to verify bearer tokens, at least one of
+// HMACSecret, RSAPublicKey or JWKSFile must be set
+type AuthConfigurations struct {
+	HMACSecret   string `koanf:"hmac-secret"`
+	RSAPublicKey string `koanf:"rsa-public-key"`
+	JWKSFile     string `koanf:"jwks-file"`
+	Issuer       string `koanf:"issuer"`
+	Audience     string `koanf:"audience"`
+}
+
+// OutboxConfigurations Delivery of the domain events stored in the outbox.
+// Publisher is either "log" or "webhook", the latter POSTs every event to
+// WebhookURL signing the body with WebhookSecret when set. Relays lease the
+// batches they publish for LeaseDuration.
+type OutboxConfigurations struct {
+	Publisher     string        `koanf:"publisher"`
+	WebhookURL    string        `koanf:"webhook-url"`
+	WebhookSecret string        `koanf:"webhook-secret"`
+	PollInterval  time.Duration `koanf:"poll-interval"`
+	BatchSize     int           `koanf:"batch-size"`
+	MaxAttempts   int           `koanf:"max-attempts"`
+	LeaseDuration time.Duration `koanf:"lease-duration"`
+}
+
+// CurrencyConfigurations Exchange rates loaded at startup. RatesFile is a
+// JSON file shaped like the body of PUT /v1/exchange-rates, its rates replace
+// the stored ones of the same currency pairs.
+type CurrencyConfigurations struct {
+	RatesFile string `koanf:"rates-file"`
+}
+
+// GuestConfigurations Encryption of the personal data of guests.
+// EncryptionKey is a base64 encoded 32 bytes AES-256 key, changing it makes
+// the stored guests unreadable.
+type GuestConfigurations struct {
+	EncryptionKey string `koanf:"encryption-key"`
+}
+
+// TracingConfigurations Export of the OpenTelemetry spans. Exporter is one
+// of "none", "otlp", "stdout" or "file": otlp sends the spans over gRPC to
+// Endpoint, stdout and file write them as JSON, the latter appending to File,
+// for local testing. SampleRatio is the share of new traces sampled, all of
+// them when unset.
+type TracingConfigurations struct {
+	Exporter    string  `koanf:"exporter"`
+	Endpoint    string  `koanf:"endpoint"`
+	Insecure    bool    `koanf:"insecure"`
+	File        string  `koanf:"file"`
+	SampleRatio float64 `koanf:"sample-ratio"`
 }
 
 // LoadConfig Loads configurations depending upon the environment
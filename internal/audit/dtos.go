@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type EventResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	CreatedAt  string          `json:"created_at"`
+	HotelID    uuid.UUID       `json:"hotel_id"`
+	Actor      string          `json:"actor"`
+	RequestID  string          `json:"request_id,omitempty"`
+	EntityType string          `json:"entity_type"`
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+}
+
+type ListEventsResponse struct {
+	Results    []EventResponse `json:"results"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func NewEventResponse(e *Event) EventResponse {
+	return EventResponse{
+		ID:         e.ID,
+		CreatedAt:  e.CreatedAt.Format(time.RFC3339Nano),
+		HotelID:    e.HotelID,
+		Actor:      e.Actor,
+		RequestID:  e.RequestID,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Action:     e.Action,
+		Before:     e.Before,
+		After:      e.After,
+	}
+}
+
+func NewListEventsResponse(events Events, nextCursor string) ListEventsResponse {
+	responses := make([]EventResponse, len(events))
+	for i, event := range events {
+		responses[i] = NewEventResponse(&event)
+	}
+	return ListEventsResponse{
+		Results:    responses,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,12 @@
+package audit
+
+import (
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound       = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrInvalidTimeFilter   = terrors.BadRequest("time_range", "from and to must be RFC 3339 timestamps", nil)
+	ErrInvalidTimeRange    = terrors.BadRequest("time_range", "from must be before to", nil)
+	ErrInvalidEntityFilter = terrors.BadRequest("entity_id", "entity_id must be a valid identifier", nil)
+)
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type AuditController struct {
+	auditService *AuditService
+	log          *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	auditService *AuditService,
+	membershipChecker auth.HotelMembershipChecker,
+) *AuditController {
+	c := &AuditController{
+		auditService: auditService,
+		log:          logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHotelRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Get("/v1/hotels/{hotel_id}/audit", c.handleListHotelAuditEvents)
+	})
+
+	return c
+}
+
+func (c *AuditController) handleListHotelAuditEvents(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := EventFilters{
+		EntityType: query.Get("entity_type"),
+	}
+	if rawFrom := query.Get("from"); rawFrom != "" {
+		from, err := time.Parse(time.RFC3339, rawFrom)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidTimeFilter)
+			return
+		}
+		filters.From = &from
+	}
+	if rawTo := query.Get("to"); rawTo != "" {
+		to, err := time.Parse(time.RFC3339, rawTo)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidTimeFilter)
+			return
+		}
+		filters.To = &to
+	}
+	if rawEntityID := query.Get("entity_id"); rawEntityID != "" {
+		entityID, err := uuid.FromString(rawEntityID)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidEntityFilter)
+			return
+		}
+		filters.EntityID = &entityID
+	}
+
+	events, nextCursor, err := c.auditService.ListEventsByHotelID(r.Context(), uuidHotelID, filters, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListEventsResponse(events, nextCursor)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+// --------------------
+// DB models
+// --------------------
+type Event struct {
+	bun.BaseModel `bun:"table:audit_events"`
+	ID            uuid.UUID       `bun:"id"`
+	CreatedAt     time.Time       `bun:"created_at"`
+	HotelID       uuid.UUID       `bun:"hotel_id"`
+	Actor         string          `bun:"actor"`
+	RequestID     string          `bun:"request_id"`
+	EntityType    string          `bun:"entity_type"`
+	EntityID      uuid.UUID       `bun:"entity_id"`
+	Action        string          `bun:"action"`
+	Before        json.RawMessage `bun:"before,type:jsonb,nullzero"`
+	After         json.RawMessage `bun:"after,type:jsonb,nullzero"`
+}
+
+type Events []Event
+
+// EventFilters narrows down audit listings, empty fields are ignored
+type EventFilters struct {
+	From       *time.Time
+	To         *time.Time
+	EntityType string
+	EntityID   *uuid.UUID
+}
+
+// SortableColumns public sort keys of audit listings and their columns
+var SortableColumns = map[string]string{
+	"created_at": "created_at",
+}
+
+// DefaultSort lists the most recent changes first
+const DefaultSort = "-created_at"
+
+func cursorKey(event Event, _ string) (string, uuid.UUID) {
+	return event.CreatedAt.Format(time.RFC3339Nano), event.ID
+}
+
+// NewEvent Builds the audit event of a change, snapshotting the before and
+// after states as JSON
+func NewEvent(actor core.Actor, change core.Change) (*Event, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := snapshot(change.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := snapshot(change.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		ID:         id,
+		CreatedAt:  time.Now().UTC(),
+		HotelID:    change.HotelID,
+		Actor:      actor.Principal,
+		RequestID:  actor.RequestID,
+		EntityType: change.EntityType,
+		EntityID:   change.EntityID,
+		Action:     change.Action,
+		Before:     before,
+		After:      after,
+	}, nil
+}
+
+func snapshot(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
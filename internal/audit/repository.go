@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+// Append Stores the audit events of the records using db, which is expected
+// to be the transaction of the changes they audit.
+func Append(ctx context.Context, db bun.IDB, records ...core.AuditRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	events := make(Events, len(records))
+	for i, record := range records {
+		event, err := NewEvent(record.Actor, record.Change)
+		if err != nil {
+			return err
+		}
+		events[i] = *event
+	}
+
+	_, err := db.NewInsert().Model(&events).Exec(ctx)
+	return err
+}
+
+type AuditRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *AuditRepository {
+	return &AuditRepository{
+		db: db,
+	}
+}
+
+// GetByHotelID Returns a page of the hotel's audit events matching the
+// filters along with the cursor of the next page
+func (r *AuditRepository) GetByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters EventFilters, page pagination.Params,
+) (Events, string, error) {
+	var events Events
+	q := r.db.NewSelect().
+		Model(&events).
+		Where("hotel_id = ?", hotelID)
+
+	if filters.From != nil {
+		q = q.Where("created_at >= ?", *filters.From)
+	}
+	if filters.To != nil {
+		q = q.Where("created_at < ?", *filters.To)
+	}
+	if filters.EntityType != "" {
+		q = q.Where("entity_type = ?", filters.EntityType)
+	}
+	if filters.EntityID != nil {
+		q = q.Where("entity_id = ?", *filters.EntityID)
+	}
+
+	err := page.Apply(q).Scan(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	events, nextCursor := pagination.Paginate(events, page, cursorKey)
+
+	return events, nextCursor, nil
+}
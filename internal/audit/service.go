@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type AuditService struct {
+	auditRepo *AuditRepository
+	log       *zap.SugaredLogger
+}
+
+func NewService(auditRepo *AuditRepository) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+		log:       logger.GetLogger(),
+	}
+}
+
+func (s *AuditService) ListEventsByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters EventFilters, page pagination.Params,
+) (Events, string, error) {
+	if filters.From != nil && filters.To != nil && !filters.From.Before(*filters.To) {
+		return nil, "", ErrInvalidTimeRange
+	}
+
+	events, nextCursor, err := s.auditRepo.GetByHotelID(ctx, hotelID, filters, page)
+	if err != nil {
+		s.log.Errorw("error retrieving audit events by hotel ID", "hotelID", hotelID, "error", err)
+		return nil, "", err
+	}
+	return events, nextCursor, nil
+}
@@ -0,0 +1,55 @@
+package availability
+
+import (
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+type RoomTypeAvailabilityResponse struct {
+	RoomTypeID     uuid.UUID       `json:"room_type_id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	NumberOfBeds   int             `json:"number_of_beds"`
+	BedType        string          `json:"bed_type"`
+	MaxOccupancy   int             `json:"max_occupancy"`
+	BasePrice      decimal.Decimal `json:"base_price"`
+	AvailableRooms int             `json:"available_rooms"`
+}
+
+type AvailabilityResponse struct {
+	CheckIn  string                         `json:"check_in"`
+	CheckOut string                         `json:"check_out"`
+	Guests   int                            `json:"guests"`
+	Results  []RoomTypeAvailabilityResponse `json:"results"`
+}
+
+func NewAvailabilityResponse(
+	checkIn, checkOut time.Time,
+	guests int,
+	roomTypes roomtype.RoomTypes,
+	availableRooms map[uuid.UUID]int,
+) AvailabilityResponse {
+	results := make([]RoomTypeAvailabilityResponse, len(roomTypes))
+	for i, rt := range roomTypes {
+		results[i] = RoomTypeAvailabilityResponse{
+			RoomTypeID:     rt.ID,
+			Name:           rt.Name,
+			Description:    rt.Description,
+			NumberOfBeds:   rt.NumberOfBeds,
+			BedType:        rt.BedType,
+			MaxOccupancy:   rt.MaxOccupancy,
+			BasePrice:      rt.BasePrice,
+			AvailableRooms: availableRooms[rt.ID],
+		}
+	}
+	return AvailabilityResponse{
+		CheckIn:  checkIn.Format(time.DateOnly),
+		CheckOut: checkOut.Format(time.DateOnly),
+		Guests:   guests,
+		Results:  results,
+	}
+}
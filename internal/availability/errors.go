@@ -0,0 +1,8 @@
+package availability
+
+import "github.com/monzo/terrors"
+
+var (
+	ErrInvalidStayDates = terrors.BadRequest("stay_dates", "check_in and check_out must be dates with check_out after check_in", nil)
+	ErrInvalidGuests    = terrors.BadRequest("guests", "guests must be a positive number", nil)
+)
@@ -0,0 +1,85 @@
+package availability
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type AvailabilityController struct {
+	availabilityService *AvailabilityService
+	log                 *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	availabilityService *AvailabilityService,
+	membershipChecker auth.HotelMembershipChecker,
+) *AvailabilityController {
+	c := &AvailabilityController{
+		availabilityService: availabilityService,
+		log:                 logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/availability", c.handleSearchAvailability)
+	})
+
+	return c
+}
+
+func (c *AvailabilityController) handleSearchAvailability(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	query := r.URL.Query()
+
+	checkIn, err := time.Parse(time.DateOnly, query.Get("check_in"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidStayDates)
+		return
+	}
+	checkOut, err := time.Parse(time.DateOnly, query.Get("check_out"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidStayDates)
+		return
+	}
+
+	guests := 1
+	if rawGuests := query.Get("guests"); rawGuests != "" {
+		guests, err = strconv.Atoi(rawGuests)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidGuests)
+			return
+		}
+	}
+
+	roomTypes, availableRooms, err := c.availabilityService.SearchAvailability(
+		r.Context(), uuidHotelID, checkIn, checkOut, guests,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewAvailabilityResponse(checkIn, checkOut, guests, roomTypes, availableRooms)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
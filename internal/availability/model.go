@@ -0,0 +1,12 @@
+package availability
+
+import (
+	"github.com/gofrs/uuid/v5"
+)
+
+// RoomTypeAvailability number of rooms of a room type that are free for
+// every night of a requested stay.
+type RoomTypeAvailability struct {
+	RoomTypeID     uuid.UUID `bun:"room_type_id"`
+	AvailableRooms int       `bun:"available_rooms"`
+}
@@ -0,0 +1,52 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type AvailabilityRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *AvailabilityRepository {
+	return &AvailabilityRepository{
+		db: db,
+	}
+}
+
+// GetByHotelID Counts, per room type of the hotel able to host the given
+// number of guests, the rooms without an active reservation nor an out of
+// order block on any night between checkIn and checkOut.
+func (r *AvailabilityRepository) GetByHotelID(
+	ctx context.Context, hotelID uuid.UUID, checkIn, checkOut time.Time, guests int,
+) ([]RoomTypeAvailability, error) {
+	var availability []RoomTypeAvailability
+	err := r.db.NewSelect().
+		TableExpr("room_types AS rt").
+		ColumnExpr("rt.id AS room_type_id").
+		ColumnExpr("COUNT(r.id) AS available_rooms").
+		Join(`LEFT JOIN rooms AS r ON r.room_type_id = rt.id AND r.deleted_at IS NULL AND NOT EXISTS (
+			SELECT 1 FROM reservations AS res
+			WHERE res.room_id = r.id
+			AND res.status <> 'cancelled'
+			AND daterange(res.check_in, res.check_out, '[)') && daterange(?::date, ?::date, '[)')
+		) AND NOT EXISTS (
+			SELECT 1 FROM room_blocks AS b
+			WHERE b.room_id = r.id
+			AND daterange(b.start_date, b.end_date, '[)') && daterange(?::date, ?::date, '[)')
+		)`, checkIn, checkOut, checkIn, checkOut).
+		Where("rt.hotel_id = ?", hotelID).
+		Where("rt.deleted_at IS NULL").
+		Where("rt.max_occupancy >= ?", guests).
+		Group("rt.id").
+		Scan(ctx, &availability)
+	if err != nil {
+		return nil, err
+	}
+
+	return availability, nil
+}
@@ -0,0 +1,121 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/inventory"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type AvailabilityService struct {
+	availabilityRepo *AvailabilityRepository
+	roomTypeService  *roomtype.RoomTypeService
+	inventoryService *inventory.InventoryService
+	log              *zap.SugaredLogger
+}
+
+func NewService(
+	availabilityRepo *AvailabilityRepository,
+	roomTypeService *roomtype.RoomTypeService,
+	inventoryService *inventory.InventoryService,
+) *AvailabilityService {
+	return &AvailabilityService{
+		availabilityRepo: availabilityRepo,
+		roomTypeService:  roomTypeService,
+		inventoryService: inventoryService,
+		log:              logger.GetLogger(),
+	}
+}
+
+// SearchAvailability Returns the room types of the hotel fitting the party
+// size along with how many of their rooms are free for the whole stay. Rooms
+// are only counted up to what the inventory of their type still sells, room
+// types whose sales controls do not allow the stay have none.
+func (s *AvailabilityService) SearchAvailability(
+	ctx context.Context, hotelID uuid.UUID, checkIn, checkOut time.Time, guests int,
+) (roomtype.RoomTypes, map[uuid.UUID]int, error) {
+	if !checkOut.After(checkIn) {
+		return nil, nil, ErrInvalidStayDates
+	}
+	if guests < 1 {
+		return nil, nil, ErrInvalidGuests
+	}
+
+	roomTypes, err := s.listFittingRoomTypes(ctx, hotelID, guests)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	availability, err := s.availabilityRepo.GetByHotelID(ctx, hotelID, checkIn, checkOut, guests)
+	if err != nil {
+		s.log.Errorw("error computing hotel availability", "hotelID", hotelID, "error", err)
+		return nil, nil, err
+	}
+
+	calendars, err := s.inventoryService.StayCalendars(ctx, hotelID, checkIn, checkOut)
+	if err != nil {
+		return nil, nil, err
+	}
+	calendarByRoomType := make(map[uuid.UUID]*inventory.RoomTypeCalendar, len(calendars))
+	for i := range calendars {
+		calendarByRoomType[calendars[i].RoomTypeID] = &calendars[i]
+	}
+
+	availableRooms := make(map[uuid.UUID]int, len(availability))
+	for _, a := range availability {
+		rooms := a.AvailableRooms
+		if calendar, ok := calendarByRoomType[a.RoomTypeID]; ok {
+			if calendar.CheckStay() != nil {
+				rooms = 0
+			} else {
+				rooms = min(rooms, calendar.SellableRooms())
+			}
+		}
+		availableRooms[a.RoomTypeID] = rooms
+	}
+
+	fitting := make(roomtype.RoomTypes, 0, len(availability))
+	for _, rt := range roomTypes {
+		if _, ok := availableRooms[rt.ID]; ok {
+			fitting = append(fitting, rt)
+		}
+	}
+
+	return fitting, availableRooms, nil
+}
+
+// listFittingRoomTypes Walks every page of the hotel's room types able to
+// host the party.
+func (s *AvailabilityService) listFittingRoomTypes(
+	ctx context.Context, hotelID uuid.UUID, guests int,
+) (roomtype.RoomTypes, error) {
+	filters := roomtype.RoomTypeFilters{MinOccupancy: guests}
+
+	var roomTypes roomtype.RoomTypes
+	cursor := ""
+	for {
+		page, err := pagination.NewParams(
+			pagination.MaxLimit, cursor, roomtype.DefaultSort, roomtype.SortableColumns, roomtype.DefaultSort,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		results, nextCursor, err := s.roomTypeService.ListRoomTypesByHotelID(ctx, hotelID, filters, page)
+		if err != nil {
+			return nil, err
+		}
+		roomTypes = append(roomTypes, results...)
+
+		if nextCursor == "" {
+			return roomTypes, nil
+		}
+		cursor = nextCursor
+	}
+}
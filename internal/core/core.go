@@ -1,11 +1,130 @@
 package core
 
-import "github.com/gofrs/uuid/v5"
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/gofrs/uuid/v5"
+)
 
 type HotelValidator interface {
-	ValidateHotelExists(id uuid.UUID) (bool, error)
+	ValidateHotelExists(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// HotelCurrencyResolver resolves the ISO 4217 currency the prices of a hotel
+// are denominated in
+type HotelCurrencyResolver interface {
+	ResolveHotelCurrency(ctx context.Context, id uuid.UUID) (string, error)
 }
 
 type RoomTypeValidator interface {
-	ValidateRoomTypeExists(id uuid.UUID) (bool, error)
+	ValidateHotelRoomTypeExists(ctx context.Context, hotelID, roomTypeID uuid.UUID) (bool, error)
+}
+
+type HotelMembershipResolver interface {
+	ListMemberHotelIDs(ctx context.Context, principal string) ([]uuid.UUID, error)
+}
+
+type RoomValidator interface {
+	ValidateHotelRoomExists(ctx context.Context, hotelID, roomID uuid.UUID) (bool, error)
+}
+
+// RoomOccupancyResolver resolves how many guests a hotel's room hosts, as
+// set by its room type
+type RoomOccupancyResolver interface {
+	ResolveRoomMaxOccupancy(ctx context.Context, hotelID, roomID uuid.UUID) (int, error)
+}
+
+// GuestContact name and email of a guest profile, decrypted
+type GuestContact struct {
+	Name  string
+	Email string
+}
+
+// GuestDirectory links records to the hotel's guest profiles, the only place
+// the personal data of guests is stored, encrypted
+type GuestDirectory interface {
+	// FindOrCreateGuest Returns the hotel's guest with the email, creating a
+	// profile when there is none
+	FindOrCreateGuest(ctx context.Context, hotelID uuid.UUID, name string, email string) (uuid.UUID, error)
+	// ResolveGuestContacts Returns the contacts of the hotel's guests found by
+	// their IDs
+	ResolveGuestContacts(ctx context.Context, hotelID uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]GuestContact, error)
+}
+
+// Actor who performs a mutation and the request it was made in
+type Actor struct {
+	Principal string
+	RequestID string
+}
+
+// ActorFromContext Returns the actor of a request. Calls without an
+// authenticated principal, such as background jobs, act as the system
+// principal.
+func ActorFromContext(ctx context.Context) Actor {
+	actor := Actor{
+		Principal: auth.SystemPrincipal.Subject,
+		RequestID: chimiddleware.GetReqID(ctx),
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		actor.Principal = principal.Subject
+	}
+	return actor
+}
+
+// Change made to an entity of a hotel. Before is nil on creations.
+type Change struct {
+	HotelID    uuid.UUID
+	EntityType string
+	EntityID   uuid.UUID
+	Action     string
+	Before     any
+	After      any
+}
+
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+)
+
+// AuditRecord change made by an actor. It is stored in the same transaction
+// as the change, so every committed mutation is audited.
+type AuditRecord struct {
+	Actor  Actor
+	Change Change
+}
+
+// Domain events published to downstream systems through the outbox
+const (
+	HotelCreated      = "HotelCreated"
+	HotelUpdated      = "HotelUpdated"
+	HotelDeleted      = "HotelDeleted"
+	HotelRestored     = "HotelRestored"
+	RoomTypeCreated   = "RoomTypeCreated"
+	RoomTypeUpdated   = "RoomTypeUpdated"
+	RoomTypeDeleted   = "RoomTypeDeleted"
+	RoomTypeRestored  = "RoomTypeRestored"
+	RoomCreated       = "RoomCreated"
+	RoomUpdated       = "RoomUpdated"
+	RoomDeleted       = "RoomDeleted"
+	RoomRestored      = "RoomRestored"
+	RoomStatusChanged = "RoomStatusChanged"
+	RatePlanCreated   = "RatePlanCreated"
+	RatePlanUpdated   = "RatePlanUpdated"
+	RatePlanDeleted   = "RatePlanDeleted"
+	InventoryUpdated  = "InventoryUpdated"
+)
+
+// DomainEvent fact about a hotel's inventory. It is stored in the same
+// transaction as the change that produced it and relayed afterwards.
+type DomainEvent struct {
+	Type          string
+	HotelID       uuid.UUID
+	AggregateType string
+	AggregateID   uuid.UUID
+	Payload       any
 }
@@ -0,0 +1,13 @@
+package core
+
+import (
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+
+	"github.com/monzo/terrors"
+)
+
+// Conflict Returns a terrors.Error with the transport conflict code, for
+// operations conflicting with the current state of an entity.
+func Conflict(code, message string, params map[string]string) *terrors.Error {
+	return rest.Conflict(code, message, params)
+}
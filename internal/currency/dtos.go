@@ -0,0 +1,68 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ExchangeRateRequest struct {
+	From string          `json:"from" validate:"required,iso4217"`
+	To   string          `json:"to" validate:"required,iso4217"`
+	Rate decimal.Decimal `json:"rate"`
+}
+
+// SetExchangeRatesRequest body of the admin endpoint and contents of the
+// exchange rates file
+type SetExchangeRatesRequest struct {
+	Rates []ExchangeRateRequest `json:"rates" validate:"required,min=1,dive"`
+}
+
+type ExchangeRateResponse struct {
+	From      string          `json:"from"`
+	To        string          `json:"to"`
+	Rate      decimal.Decimal `json:"rate"`
+	UpdatedAt string          `json:"updated_at"`
+}
+
+type ListExchangeRatesResponse struct {
+	Results []ExchangeRateResponse `json:"results"`
+}
+
+func NewExchangeRateResponse(r *ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		From:      r.FromCurrency,
+		To:        r.ToCurrency,
+		Rate:      r.Rate,
+		UpdatedAt: r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListExchangeRatesResponse(rates ExchangeRates) ListExchangeRatesResponse {
+	responses := make([]ExchangeRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = NewExchangeRateResponse(&rate)
+	}
+	return ListExchangeRatesResponse{
+		Results: responses,
+	}
+}
+
+// NewExchangeRates Builds the exchange rates of a request
+func NewExchangeRates(req SetExchangeRatesRequest) (ExchangeRates, error) {
+	rates := make(ExchangeRates, len(req.Rates))
+	seen := make(map[[2]string]bool, len(req.Rates))
+	for i, r := range req.Rates {
+		rate, err := NewExchangeRate(r.From, r.To, r.Rate)
+		if err != nil {
+			return nil, err
+		}
+		pair := [2]string{rate.FromCurrency, rate.ToCurrency}
+		if seen[pair] {
+			return nil, ErrDuplicateExchangeRate
+		}
+		seen[pair] = true
+		rates[i] = *rate
+	}
+	return rates, nil
+}
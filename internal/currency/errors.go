@@ -0,0 +1,14 @@
+package currency
+
+import (
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrInvalidCurrency = terrors.BadRequest(
+		"currency", "currencies must be distinct three letter ISO 4217 codes", nil,
+	)
+	ErrInvalidRate           = terrors.BadRequest("rate", "rate must be a positive decimal number", nil)
+	ErrDuplicateExchangeRate = terrors.BadRequest("rates", "each currency pair can only be given once", nil)
+	ErrExchangeRateNotFound  = terrors.NotFound("exchange_rate", "there is no exchange rate between the currencies", nil)
+)
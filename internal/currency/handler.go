@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type CurrencyController struct {
+	validator       *validator.Validate
+	currencyService *CurrencyService
+	log             *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	currencyService *CurrencyService,
+) *CurrencyController {
+	c := &CurrencyController{
+		validator:       validator,
+		currencyService: currencyService,
+		log:             logger.GetLogger(),
+	}
+
+	// Exchange rates are shared by every hotel of the chain
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateRead))
+		r.Get("/v1/exchange-rates", c.handleListExchangeRates)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleAdmin))
+		r.Put("/v1/exchange-rates", c.handleSetExchangeRates)
+		r.Delete("/v1/exchange-rates/{from}/{to}", c.handleDeleteExchangeRate)
+	})
+
+	return c
+}
+
+func (c *CurrencyController) handleListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	rates, err := c.currencyService.ListExchangeRates(r.Context())
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListExchangeRatesResponse(rates)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *CurrencyController) handleSetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	var payload SetExchangeRatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	rates, err := NewExchangeRates(payload)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	rates, err = c.currencyService.SetExchangeRates(r.Context(), rates)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListExchangeRatesResponse(rates)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *CurrencyController) handleDeleteExchangeRate(w http.ResponseWriter, r *http.Request) {
+	err := c.currencyService.DeleteExchangeRate(r.Context(), chi.URLParam(r, "from"), chi.URLParam(r, "to"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// DefaultCurrency currency of the hotels created without one
+const DefaultCurrency = "USD"
+
+// codeValidator checks currency codes against the ISO 4217 list, the same
+// as the iso4217 tag of the request bodies
+var codeValidator = validator.New()
+
+// minorUnits digits kept after the decimal point by the currencies whose
+// ISO 4217 exponent is not 2. Every other currency keeps two digits.
+var minorUnits = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// --------------------
+// DB models
+// --------------------
+
+// ExchangeRate amount of ToCurrency bought by one unit of FromCurrency
+type ExchangeRate struct {
+	bun.BaseModel `bun:"table:exchange_rates"`
+	FromCurrency  string          `bun:"from_currency,pk"`
+	ToCurrency    string          `bun:"to_currency,pk"`
+	Rate          decimal.Decimal `bun:"rate"`
+	UpdatedAt     time.Time       `bun:"updated_at"`
+}
+
+type ExchangeRates []ExchangeRate
+
+func NewExchangeRate(from string, to string, rate decimal.Decimal) (*ExchangeRate, error) {
+	from, to = Normalize(from), Normalize(to)
+	if !Valid(from) || !Valid(to) || from == to {
+		return nil, ErrInvalidCurrency
+	}
+	if !rate.IsPositive() {
+		return nil, ErrInvalidRate
+	}
+
+	return &ExchangeRate{
+		FromCurrency: from,
+		ToCurrency:   to,
+		Rate:         rate,
+		UpdatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// Normalize Returns the code of a currency in upper case
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Valid Reports whether code is an ISO 4217 currency code
+func Valid(code string) bool {
+	return codeValidator.Var(code, "iso4217") == nil
+}
+
+// MinorUnits Returns the digits kept after the decimal point by amounts of
+// the currency
+func MinorUnits(code string) int32 {
+	if units, ok := minorUnits[code]; ok {
+		return units
+	}
+	return 2
+}
+
+// Round Rounds amount to the minor units of the currency, halves are rounded
+// away from zero, e.g. 10.005 USD is 10.01 USD and 1234.5 JPY is 1235 JPY
+func Round(amount decimal.Decimal, code string) decimal.Decimal {
+	return amount.Round(MinorUnits(code))
+}
+
+// Converter converts amounts between two currencies at a fixed rate
+type Converter struct {
+	From string
+	To   string
+	rate decimal.Decimal
+}
+
+// NewConverter Returns the converter of amounts in from into to at rate,
+// the amount of to bought by one unit of from
+func NewConverter(from string, to string, rate decimal.Decimal) Converter {
+	return Converter{From: from, To: to, rate: rate}
+}
+
+// Convert Returns amount in the target currency. The amount is multiplied at
+// full precision and the result is rounded once to the minor units of the
+// target currency, amounts already rounded in the source currency keep that
+// rounding.
+func (c Converter) Convert(amount decimal.Decimal) decimal.Decimal {
+	if c.From == c.To {
+		return amount
+	}
+	return Round(amount.Mul(c.rate), c.To)
+}
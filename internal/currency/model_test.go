@@ -0,0 +1,65 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     string
+	}{
+		{name: "two minor units", amount: "10.004", currency: "USD", want: "10.00"},
+		{name: "half rounded up", amount: "10.005", currency: "USD", want: "10.01"},
+		{name: "negative half rounded down", amount: "-10.005", currency: "USD", want: "-10.01"},
+		{name: "no minor units", amount: "1234.49", currency: "JPY", want: "1234"},
+		{name: "no minor units half", amount: "1234.5", currency: "JPY", want: "1235"},
+		{name: "no minor units negative half", amount: "-1234.5", currency: "JPY", want: "-1235"},
+		{name: "three minor units", amount: "1.23449", currency: "KWD", want: "1.234"},
+		{name: "three minor units half", amount: "1.2345", currency: "KWD", want: "1.235"},
+		{name: "three minor units negative half", amount: "-1.2345", currency: "KWD", want: "-1.235"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := currency.Round(decimal.RequireFromString(tt.amount), tt.currency)
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Fatalf("expected %s %s, got %s", tt.want, tt.currency, got)
+			}
+		})
+	}
+}
+
+func TestConverterConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		rate   string
+		amount string
+		want   string
+	}{
+		{name: "same currency", from: "USD", to: "USD", rate: "1", amount: "10.005", want: "10.005"},
+		{name: "into no minor units", from: "USD", to: "JPY", rate: "151.235", amount: "10.00", want: "1512"},
+		{name: "into no minor units half", from: "USD", to: "JPY", rate: "150.05", amount: "10.00", want: "1501"},
+		{name: "into three minor units", from: "USD", to: "KWD", rate: "0.3075", amount: "19.99", want: "6.147"},
+		{name: "from no minor units", from: "JPY", to: "USD", rate: "0.0066", amount: "1235", want: "8.15"},
+		{name: "from three minor units", from: "KWD", to: "USD", rate: "3.25", amount: "1.235", want: "4.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter := currency.NewConverter(tt.from, tt.to, decimal.RequireFromString(tt.rate))
+			got := converter.Convert(decimal.RequireFromString(tt.amount))
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Fatalf("expected %s %s, got %s", tt.want, tt.to, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+)
+
+type ExchangeRateRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		db: db,
+	}
+}
+
+// SaveAll Creates the exchange rates or replaces the rate of the currency
+// pairs that already have one
+func (r *ExchangeRateRepository) SaveAll(ctx context.Context, rates ExchangeRates) error {
+	_, err := r.db.NewInsert().
+		Model(&rates).
+		On("CONFLICT (from_currency, to_currency) DO UPDATE").
+		Set("rate = EXCLUDED.rate").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *ExchangeRateRepository) Delete(ctx context.Context, from string, to string) error {
+	_, err := r.db.NewDelete().
+		Model((*ExchangeRate)(nil)).
+		Where("from_currency = ?", from).
+		Where("to_currency = ?", to).
+		Exec(ctx)
+	return err
+}
+
+func (r *ExchangeRateRepository) GetAll(ctx context.Context) (ExchangeRates, error) {
+	var rates ExchangeRates
+	err := r.db.NewSelect().
+		Model(&rates).
+		Order("from_currency ASC", "to_currency ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// GetByPair Returns the rate converting from into to, or the one converting
+// to into from when there is no direct rate
+func (r *ExchangeRateRepository) GetByPair(ctx context.Context, from string, to string) (*ExchangeRate, error) {
+	rate := new(ExchangeRate)
+	err := r.db.NewSelect().
+		Model(rate).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("from_currency = ? AND to_currency = ?", from, to).
+				WhereOr("from_currency = ? AND to_currency = ?", to, from)
+		}).
+		// The direct rate wins over the inverse one
+		OrderExpr("from_currency = ? DESC", from).
+		Limit(1).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
@@ -0,0 +1,107 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+type CurrencyService struct {
+	exchangeRateRepo *ExchangeRateRepository
+	log              *zap.SugaredLogger
+}
+
+func NewService(exchangeRateRepo *ExchangeRateRepository) *CurrencyService {
+	return &CurrencyService{
+		exchangeRateRepo: exchangeRateRepo,
+		log:              logger.GetLogger(),
+	}
+}
+
+func (s *CurrencyService) ListExchangeRates(ctx context.Context) (ExchangeRates, error) {
+	rates, err := s.exchangeRateRepo.GetAll(ctx)
+	if err != nil {
+		s.log.Errorw("error retrieving exchange rates", "error", err)
+		return nil, err
+	}
+	return rates, nil
+}
+
+// SetExchangeRates Creates or replaces the rates of the given currency
+// pairs, the rates of other pairs are kept
+func (s *CurrencyService) SetExchangeRates(ctx context.Context, rates ExchangeRates) (ExchangeRates, error) {
+	if err := s.exchangeRateRepo.SaveAll(ctx, rates); err != nil {
+		s.log.Errorw("error saving exchange rates", "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("exchange rates saved successfully", "rates", len(rates))
+
+	return s.ListExchangeRates(ctx)
+}
+
+func (s *CurrencyService) DeleteExchangeRate(ctx context.Context, from string, to string) error {
+	if err := s.exchangeRateRepo.Delete(ctx, Normalize(from), Normalize(to)); err != nil {
+		s.log.Errorw("error deleting exchange rate", "from", from, "to", to, "error", err)
+		return err
+	}
+	return nil
+}
+
+// LoadExchangeRatesFile Saves the exchange rates of a JSON file shaped like
+// the body of the admin endpoint
+func (s *CurrencyService) LoadExchangeRatesFile(ctx context.Context, path string, validate *validator.Validate) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var req SetExchangeRatesRequest
+	if err := json.Unmarshal(content, &req); err != nil {
+		return fmt.Errorf("parsing exchange rates file: %w", err)
+	}
+	if err := validate.Struct(req); err != nil {
+		return fmt.Errorf("validating exchange rates file: %w", err)
+	}
+
+	rates, err := NewExchangeRates(req)
+	if err != nil {
+		return fmt.Errorf("validating exchange rates file: %w", err)
+	}
+
+	_, err = s.SetExchangeRates(ctx, rates)
+	return err
+}
+
+// Converter Returns the converter of amounts in from into to. Pairs without
+// a direct rate are converted with the inverse of the opposite one.
+func (s *CurrencyService) Converter(ctx context.Context, from string, to string) (Converter, error) {
+	from, to = Normalize(from), Normalize(to)
+	if !Valid(from) || !Valid(to) {
+		return Converter{}, ErrInvalidCurrency
+	}
+	if from == to {
+		return NewConverter(from, to, decimal.NewFromInt(1)), nil
+	}
+
+	rate, err := s.exchangeRateRepo.GetByPair(ctx, from, to)
+	if err != nil {
+		s.log.Errorw("error retrieving exchange rate", "from", from, "to", to, "error", err)
+		return Converter{}, err
+	}
+	if rate == nil {
+		return Converter{}, ErrExchangeRateNotFound
+	}
+
+	if rate.FromCurrency == from {
+		return NewConverter(from, to, rate.Rate), nil
+	}
+	return NewConverter(from, to, decimal.NewFromInt(1).Div(rate.Rate)), nil
+}
@@ -0,0 +1,174 @@
+package folio
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+// OpenFolioRequest opens the folio for the guest profile of guest_id, or for
+// a new profile created with guest_name
+type OpenFolioRequest struct {
+	RoomID    uuid.UUID  `json:"room_id" validate:"required"`
+	GuestID   *uuid.UUID `json:"guest_id"`
+	GuestName string     `json:"guest_name" validate:"required_without=GuestID,max=128"`
+	StartDate string     `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string     `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+type PostChargeRequest struct {
+	Category    string          `json:"category" validate:"required,oneof=minibar restaurant bar laundry spa telephone parking other"`
+	Description string          `json:"description" validate:"required,max=256"`
+	Amount      decimal.Decimal `json:"amount"`
+	Date        string          `json:"date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type PostPaymentRequest struct {
+	Method    string          `json:"method" validate:"required,oneof=cash card bank_transfer"`
+	Amount    decimal.Decimal `json:"amount"`
+	Reference string          `json:"reference" validate:"max=128"`
+}
+
+type EntryResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	CreatedAt   string          `json:"created_at"`
+	Kind        string          `json:"kind"`
+	Category    string          `json:"category"`
+	Description string          `json:"description"`
+	Date        string          `json:"date"`
+	Amount      decimal.Decimal `json:"amount"`
+	Reference   string          `json:"reference,omitempty"`
+	PostedBy    string          `json:"posted_by"`
+	// Balance is the running balance of the folio after the entry
+	Balance decimal.Decimal `json:"balance"`
+}
+
+type TotalsResponse struct {
+	Charges  decimal.Decimal `json:"charges"`
+	Payments decimal.Decimal `json:"payments"`
+	Refunds  decimal.Decimal `json:"refunds"`
+	Balance  decimal.Decimal `json:"balance"`
+}
+
+type FolioResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	CreatedAt  string          `json:"created_at"`
+	UpdatedAt  string          `json:"updated_at"`
+	HotelID    uuid.UUID       `json:"hotel_id"`
+	RoomID     uuid.UUID       `json:"room_id"`
+	RoomNumber int             `json:"room_number,omitempty"`
+	GuestID    uuid.UUID       `json:"guest_id"`
+	GuestName  string          `json:"guest_name"`
+	StartDate  string          `json:"start_date"`
+	EndDate    string          `json:"end_date"`
+	Currency   string          `json:"currency"`
+	Status     string          `json:"status"`
+	OpenedBy   string          `json:"opened_by"`
+	ClosedAt   string          `json:"closed_at,omitempty"`
+	ClosedBy   string          `json:"closed_by,omitempty"`
+	Totals     *TotalsResponse `json:"totals,omitempty"`
+	Entries    []EntryResponse `json:"entries,omitempty"`
+}
+
+type ListFoliosResponse struct {
+	Results    []FolioResponse `json:"results"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type InvoiceHotelResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Address string    `json:"address"`
+	State   string    `json:"state"`
+	Country string    `json:"country"`
+}
+
+type InvoiceResponse struct {
+	Number   string               `json:"number"`
+	IssuedAt string               `json:"issued_at"`
+	Hotel    InvoiceHotelResponse `json:"hotel"`
+	Folio    FolioResponse        `json:"folio"`
+}
+
+// NewFolioResponse Maps a folio retrieved along with its entries, which
+// come with the running balance after each of them
+func NewFolioResponse(f *Folio) FolioResponse {
+	resp := newFolioSummaryResponse(f)
+
+	totals := f.Totals()
+	resp.Totals = &TotalsResponse{
+		Charges:  totals.Charges,
+		Payments: totals.Payments,
+		Refunds:  totals.Refunds,
+		Balance:  totals.Balance,
+	}
+
+	balance := decimal.Zero
+	for _, e := range f.Entries {
+		balance = balance.Add(e.SignedAmount())
+		resp.Entries = append(resp.Entries, EntryResponse{
+			ID:          e.ID,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339),
+			Kind:        e.Kind,
+			Category:    e.Category,
+			Description: e.Description,
+			Date:        e.Date.Format(time.DateOnly),
+			Amount:      e.Amount,
+			Reference:   e.Reference,
+			PostedBy:    e.PostedBy,
+			Balance:     balance,
+		})
+	}
+	return resp
+}
+
+// NewListFoliosResponse Maps folios listed without their entries
+func NewListFoliosResponse(folios Folios, nextCursor string) ListFoliosResponse {
+	responses := make([]FolioResponse, len(folios))
+	for i, f := range folios {
+		responses[i] = newFolioSummaryResponse(&f)
+	}
+	return ListFoliosResponse{
+		Results:    responses,
+		NextCursor: nextCursor,
+	}
+}
+
+func NewInvoiceResponse(inv *Invoice) InvoiceResponse {
+	return InvoiceResponse{
+		Number:   inv.Number(),
+		IssuedAt: inv.IssuedAt.Format(time.RFC3339),
+		Hotel: InvoiceHotelResponse{
+			ID:      inv.Hotel.ID,
+			Name:    inv.Hotel.Name,
+			Address: inv.Hotel.Address,
+			State:   inv.Hotel.State,
+			Country: inv.Hotel.Country,
+		},
+		Folio: NewFolioResponse(inv.Folio),
+	}
+}
+
+func newFolioSummaryResponse(f *Folio) FolioResponse {
+	resp := FolioResponse{
+		ID:         f.ID,
+		CreatedAt:  f.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  f.UpdatedAt.Format(time.RFC3339),
+		HotelID:    f.HotelID,
+		RoomID:     f.RoomID,
+		RoomNumber: f.RoomNumber,
+		GuestID:    f.GuestID,
+		GuestName:  f.GuestName,
+		StartDate:  f.StartDate.Format(time.DateOnly),
+		EndDate:    f.EndDate.Format(time.DateOnly),
+		Currency:   f.Currency,
+		Status:     f.Status,
+		OpenedBy:   f.OpenedBy,
+		ClosedBy:   f.ClosedBy,
+	}
+	if !f.ClosedAt.IsZero() {
+		resp.ClosedAt = f.ClosedAt.Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,21 @@
+package folio
+
+import (
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound         = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrFolioNotFound         = terrors.NotFound("folio", "folio not found", nil)
+	ErrInvalidFolioDates     = terrors.BadRequest("folio_dates", "end_date must be after start_date and within a year of it", nil)
+	ErrInvalidAmount         = terrors.BadRequest("amount", "amount must be greater than zero", nil)
+	ErrInvalidRoomID         = terrors.BadRequest("room_id", "room_id must be a valid uuid", nil)
+	ErrDateOutOfRange        = terrors.BadRequest("date", "date must be one of the nights of the folio", nil)
+	ErrInvalidInvoiceFormat  = terrors.BadRequest("format", "format must be json or pdf", nil)
+	ErrRefundExceedsPayments = terrors.BadRequest("amount", "refunds can not exceed the payments of the folio", nil)
+	ErrFolioClosed           = core.Conflict("folio_closed", "folio is closed", nil)
+	ErrFolioChanged          = core.Conflict("folio_changed", "folio was changed meanwhile, please retry", nil)
+	ErrBalanceNotSettled     = core.Conflict("balance_not_settled", "folios are closed once their balance is zero", nil)
+)
@@ -0,0 +1,368 @@
+package folio
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type FolioController struct {
+	validator    *validator.Validate
+	folioService *FolioService
+	log          *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	folioService *FolioService,
+	membershipChecker auth.HotelMembershipChecker,
+) *FolioController {
+	c := &FolioController{
+		validator:    validator,
+		folioService: folioService,
+		log:          logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleFolioRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/folios", c.handleListFolios)
+		r.Get("/v1/hotels/{hotel_id}/folios/{folio_id}", c.handleGetFolio)
+		r.Get("/v1/hotels/{hotel_id}/folios/{folio_id}/invoice", c.handleGetInvoice)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleFolioWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/folios", c.handleOpenFolio)
+		r.Post("/v1/hotels/{hotel_id}/folios/{folio_id}/room-charges", c.handlePostRoomCharges)
+		r.Post("/v1/hotels/{hotel_id}/folios/{folio_id}/charges", c.handlePostCharge)
+		r.Post("/v1/hotels/{hotel_id}/folios/{folio_id}/payments", c.handlePostPayment)
+		r.Post("/v1/hotels/{hotel_id}/folios/{folio_id}/close", c.handleCloseFolio)
+	})
+
+	// Only managers give money back to guests
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleFolioWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Post("/v1/hotels/{hotel_id}/folios/{folio_id}/refunds", c.handlePostRefund)
+	})
+
+	return c
+}
+
+func (c *FolioController) handleListFolios(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := FolioFilters{
+		Status: query.Get("status"),
+	}
+	if roomID := query.Get("room_id"); roomID != "" {
+		uuidRoomID, err := uuid.FromString(roomID)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidRoomID)
+			return
+		}
+		filters.RoomID = &uuidRoomID
+	}
+
+	folios, nextCursor, err := c.folioService.ListFolios(r.Context(), hotelID, filters, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListFoliosResponse(folios, nextCursor)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *FolioController) handleGetFolio(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	folio, err := c.folioService.RetrieveFolio(r.Context(), hotelID, folioID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+// handleGetInvoice Renders the invoice of the folio as JSON, or as a PDF
+// document with format=pdf
+func (c *FolioController) handleGetInvoice(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "pdf" {
+		rest.RenderError(r.Context(), w, ErrInvalidInvoiceFormat)
+		return
+	}
+
+	invoice, err := c.folioService.CreateInvoice(r.Context(), hotelID, folioID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	if format != "pdf" {
+		resp := NewInvoiceResponse(invoice)
+		rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+		return
+	}
+
+	document, err := invoice.PDF()
+	if err != nil {
+		c.log.Errorw("failure rendering invoice", "folioID", folioID, "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	rest.RenderFile(r.Context(), w, "application/pdf", "invoice-"+invoice.Number()+".pdf", document)
+}
+
+func (c *FolioController) handleOpenFolio(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload OpenFolioRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	// Dates were already validated against the layout
+	startDate, _ := time.Parse(time.DateOnly, payload.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, payload.EndDate)
+
+	var guestID uuid.UUID
+	if payload.GuestID != nil {
+		guestID = *payload.GuestID
+	}
+
+	folio, err := NewFolio(
+		hotelID,
+		payload.RoomID,
+		guestID,
+		payload.GuestName,
+		startDate,
+		endDate,
+		core.ActorFromContext(r.Context()).Principal,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating folio instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	folio, err = c.folioService.OpenFolio(r.Context(), folio)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *FolioController) handlePostRoomCharges(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	folio, err := c.folioService.PostRoomCharges(r.Context(), core.ActorFromContext(r.Context()), hotelID, folioID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *FolioController) handlePostCharge(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload PostChargeRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	var date *time.Time
+	if payload.Date != "" {
+		// The date was already validated against the layout
+		parsed, _ := time.Parse(time.DateOnly, payload.Date)
+		date = &parsed
+	}
+
+	folio, err := c.folioService.PostCharge(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
+		hotelID,
+		folioID,
+		payload.Category,
+		payload.Description,
+		payload.Amount,
+		date,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *FolioController) handlePostPayment(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload PostPaymentRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	folio, err := c.folioService.PostPayment(
+		r.Context(),
+		core.ActorFromContext(r.Context()), hotelID, folioID, payload.Method, payload.Amount, payload.Reference,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *FolioController) handlePostRefund(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload PostPaymentRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	folio, err := c.folioService.PostRefund(
+		r.Context(),
+		core.ActorFromContext(r.Context()), hotelID, folioID, payload.Method, payload.Amount, payload.Reference,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *FolioController) handleCloseFolio(w http.ResponseWriter, r *http.Request) {
+	hotelID, folioID, ok := c.hotelFolioIDs(w, r)
+	if !ok {
+		return
+	}
+
+	folio, err := c.folioService.CloseFolio(r.Context(), core.ActorFromContext(r.Context()), hotelID, folioID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFolioResponse(folio)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *FolioController) hotelID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return uuid.Nil, false
+	}
+	return uuidHotelID, true
+}
+
+func (c *FolioController) hotelFolioIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	folioID := chi.URLParam(r, "folio_id")
+	uuidFolioID, err := uuid.FromString(folioID)
+	if err != nil {
+		c.log.Errorw("invalid folio id", "folioID", folioID, "error", err)
+		rest.RenderError(r.Context(), w, ErrFolioNotFound)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return hotelID, uuidFolioID, true
+}
+
+// decode Reads and validates the request body into payload
+func (c *FolioController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package folio
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/hotel"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+)
+
+// Invoice statement of a folio issued to its guest
+type Invoice struct {
+	IssuedAt time.Time
+	Hotel    *hotel.Hotel
+	Folio    *Folio
+	Totals   Totals
+}
+
+func NewInvoice(h *hotel.Hotel, f *Folio, issuedAt time.Time) *Invoice {
+	return &Invoice{
+		IssuedAt: issuedAt,
+		Hotel:    h,
+		Folio:    f,
+		Totals:   f.Totals(),
+	}
+}
+
+// Number Returns the number printed on the invoice, one per folio
+func (inv *Invoice) Number() string {
+	return inv.Folio.ID.String()
+}
+
+// PDF Renders the invoice as an A4 PDF document
+func (inv *Invoice) PDF() ([]byte, error) {
+	f := inv.Folio
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 8, tr(inv.Hotel.Name), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 5, tr(inv.Hotel.Address), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr(inv.Hotel.State+", "+inv.Hotel.Country), "", 1, "L", false, 0, "")
+	pdf.Ln(8)
+
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 8, "Invoice", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	details := [][2]string{
+		{"Number", inv.Number()},
+		{"Issued", inv.IssuedAt.Format(time.DateOnly)},
+		{"Guest", f.GuestName},
+		{"Room", fmt.Sprintf("%d", f.RoomNumber)},
+		{"Stay", f.StartDate.Format(time.DateOnly) + " to " + f.EndDate.Format(time.DateOnly)},
+		{"Currency", f.Currency},
+	}
+	for _, detail := range details {
+		pdf.CellFormat(30, 5, detail[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, tr(detail[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	widths := []float64{25, 95, 30, 30}
+	pdf.SetFont("Helvetica", "B", 10)
+	for i, header := range []string{"Date", "Description", "Charges", "Credits"} {
+		align := "L"
+		if i > 1 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], 7, header, "B", 0, align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, e := range f.Entries {
+		// Refunds give back credits, they are shown as charges
+		charge, credit := formatAmount(e.Amount, f.Currency), ""
+		if e.Kind == string(PAYMENT) {
+			charge, credit = "", charge
+		}
+		pdf.CellFormat(widths[0], 6, e.Date.Format(time.DateOnly), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, tr(entryLabel(e)), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], 6, charge, "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, credit, "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	totals := [][2]string{
+		{"Total charges", formatAmount(inv.Totals.Charges, f.Currency)},
+		{"Total payments", formatAmount(inv.Totals.Payments, f.Currency)},
+		{"Total refunds", formatAmount(inv.Totals.Refunds, f.Currency)},
+		{"Balance due", formatAmount(inv.Totals.Balance, f.Currency) + " " + f.Currency},
+	}
+	for i, total := range totals {
+		if i == len(totals)-1 {
+			pdf.SetFont("Helvetica", "B", 11)
+		}
+		pdf.CellFormat(widths[0]+widths[1]+widths[2], 6, total[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, total[1], "", 1, "R", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// entryLabel Returns the description of the entry as printed on the invoice
+func entryLabel(e Entry) string {
+	switch EntryKind(e.Kind) {
+	case PAYMENT, REFUND:
+		label := e.Description + " (" + e.Category + ")"
+		if e.Reference != "" {
+			label += " " + e.Reference
+		}
+		return label
+	}
+	return e.Description
+}
+
+// formatAmount Returns the amount with the minor units of its currency
+func formatAmount(amount decimal.Decimal, code string) string {
+	return amount.StringFixed(currency.MinorUnits(code))
+}
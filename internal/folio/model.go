@@ -0,0 +1,223 @@
+package folio
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type Status string
+
+const (
+	OPEN   Status = "open"
+	CLOSED Status = "closed"
+)
+
+type EntryKind string
+
+const (
+	ROOM_CHARGE EntryKind = "room_charge"
+	CHARGE      EntryKind = "charge"
+	PAYMENT     EntryKind = "payment"
+	REFUND      EntryKind = "refund"
+)
+
+// MaxNights longest date range a folio can be opened for
+const MaxNights = 366
+
+// --------------------
+// DB models
+// --------------------
+
+// Folio account of the charges and payments of a guest staying in a room of
+// the hotel, from StartDate until EndDate exclusive. Amounts are in the
+// currency of the room's room type.
+type Folio struct {
+	bun.BaseModel `bun:"table:folios,alias:f"`
+	ID            uuid.UUID `bun:"id,pk"`
+	CreatedAt     time.Time `bun:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	RoomID        uuid.UUID `bun:"room_id"`
+	GuestID       uuid.UUID `bun:"guest_id,nullzero"`
+	StartDate     time.Time `bun:"start_date,type:date"`
+	EndDate       time.Time `bun:"end_date,type:date"`
+	Currency      string    `bun:"currency"`
+	Status        string    `bun:"status"`
+	OpenedBy      string    `bun:"opened_by"`
+	ClosedAt      time.Time `bun:"closed_at,nullzero"`
+	ClosedBy      string    `bun:"closed_by"`
+	// RoomNumber is read from the folio's room when retrieving a single folio
+	RoomNumber int `bun:"room_number,scanonly"`
+	// GuestName is read from the guest profile, it is not stored
+	GuestName string `bun:"-"`
+
+	Entries Entries `bun:"rel:has-many,join:id=folio_id"`
+}
+
+type Folios []Folio
+
+// Entry charge, payment or refund posted to a folio. Amounts are always
+// positive, the kind tells whether they add to or settle the balance.
+type Entry struct {
+	bun.BaseModel `bun:"table:folio_entries"`
+	ID            uuid.UUID       `bun:"id,pk"`
+	CreatedAt     time.Time       `bun:"created_at"`
+	FolioID       uuid.UUID       `bun:"folio_id"`
+	Kind          string          `bun:"kind"`
+	Category      string          `bun:"category"`
+	Description   string          `bun:"description"`
+	Date          time.Time       `bun:"date,type:date"`
+	Amount        decimal.Decimal `bun:"amount"`
+	Reference     string          `bun:"reference"`
+	PostedBy      string          `bun:"posted_by"`
+}
+
+type Entries []Entry
+
+// FolioFilters narrows down folio listings, empty fields are ignored
+type FolioFilters struct {
+	Status string
+	RoomID *uuid.UUID
+}
+
+// SortableColumns public sort keys of folio listings and their columns
+var SortableColumns = map[string]string{
+	"created_at": "created_at",
+	"start_date": "start_date",
+}
+
+// DefaultSort lists the most recent folios first
+const DefaultSort = "-created_at"
+
+func cursorKey(f Folio, column string) (string, uuid.UUID) {
+	if column == "start_date" {
+		return f.StartDate.Format(time.DateOnly), f.ID
+	}
+	return f.CreatedAt.Format(time.RFC3339Nano), f.ID
+}
+
+// Totals sums of the entries of a folio. Balance is the amount the guest
+// owes, negative when the hotel owes the guest.
+type Totals struct {
+	Charges  decimal.Decimal
+	Payments decimal.Decimal
+	Refunds  decimal.Decimal
+	Balance  decimal.Decimal
+}
+
+func NewFolio(
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	guestID uuid.UUID,
+	guestName string,
+	startDate time.Time,
+	endDate time.Time,
+	openedBy string,
+) (*Folio, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Folio{
+		ID:        id,
+		CreatedAt: now,
+		UpdatedAt: now,
+		HotelID:   hotelID,
+		RoomID:    roomID,
+		GuestID:   guestID,
+		GuestName: guestName,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Status:    string(OPEN),
+		OpenedBy:  openedBy,
+	}, nil
+}
+
+func NewEntry(
+	folioID uuid.UUID,
+	kind EntryKind,
+	category string,
+	description string,
+	date time.Time,
+	amount decimal.Decimal,
+	reference string,
+	postedBy string,
+) (*Entry, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:          id,
+		CreatedAt:   time.Now().UTC(),
+		FolioID:     folioID,
+		Kind:        string(kind),
+		Category:    category,
+		Description: description,
+		Date:        date,
+		Amount:      amount,
+		Reference:   reference,
+		PostedBy:    postedBy,
+	}, nil
+}
+
+// SignedAmount Amount the entry adds to the balance of its folio
+func (e Entry) SignedAmount() decimal.Decimal {
+	if e.Kind == string(PAYMENT) {
+		return e.Amount.Neg()
+	}
+	return e.Amount
+}
+
+// Totals Returns the sums of the folio's entries
+func (f *Folio) Totals() Totals {
+	totals := Totals{
+		Charges:  decimal.Zero,
+		Payments: decimal.Zero,
+		Refunds:  decimal.Zero,
+		Balance:  decimal.Zero,
+	}
+	for _, e := range f.Entries {
+		switch EntryKind(e.Kind) {
+		case ROOM_CHARGE, CHARGE:
+			totals.Charges = totals.Charges.Add(e.Amount)
+		case PAYMENT:
+			totals.Payments = totals.Payments.Add(e.Amount)
+		case REFUND:
+			totals.Refunds = totals.Refunds.Add(e.Amount)
+		}
+		totals.Balance = totals.Balance.Add(e.SignedAmount())
+	}
+	return totals
+}
+
+// UnpostedNights Returns the nights of the folio up to until, inclusive,
+// without a room charge posted yet
+func (f *Folio) UnpostedNights(until time.Time) []time.Time {
+	posted := make(map[string]bool)
+	for _, e := range f.Entries {
+		if e.Kind == string(ROOM_CHARGE) {
+			posted[e.Date.Format(time.DateOnly)] = true
+		}
+	}
+
+	var nights []time.Time
+	for night := f.StartDate; night.Before(f.EndDate) && !night.After(until); night = night.AddDate(0, 0, 1) {
+		if !posted[night.Format(time.DateOnly)] {
+			nights = append(nights, night)
+		}
+	}
+	return nights
+}
+
+// Covers Tells whether the date is one of the nights of the folio
+func (f *Folio) Covers(date time.Time) bool {
+	return !date.Before(f.StartDate) && date.Before(f.EndDate)
+}
@@ -0,0 +1,133 @@
+package folio
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type FolioRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *FolioRepository {
+	return &FolioRepository{
+		db: db,
+	}
+}
+
+func (r *FolioRepository) Save(ctx context.Context, folio *Folio) error {
+	_, err := r.db.NewInsert().
+		Model(folio).
+		Exec(ctx)
+	return err
+}
+
+// Post Posts the entries to the folio unless it was closed or changed since
+// it was read, so entries validated against its balance stay valid
+func (r *FolioRepository) Post(ctx context.Context, folio *Folio, entries Entries) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := touch(ctx, tx, folio, time.Now().UTC()); err != nil {
+			return err
+		}
+		_, err := tx.NewInsert().Model(&entries).Exec(ctx)
+		return err
+	})
+}
+
+// Close Closes the folio unless it was changed since it was read
+func (r *FolioRepository) Close(ctx context.Context, folio *Folio) error {
+	res, err := r.db.NewUpdate().
+		Model(folio).
+		Set("updated_at = ?", folio.ClosedAt).
+		Set("status = ?", CLOSED).
+		Set("closed_at = ?", folio.ClosedAt).
+		Set("closed_by = ?", folio.ClosedBy).
+		WherePK().
+		Where("status = ?", OPEN).
+		Where("updated_at = ?", folio.UpdatedAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrFolioChanged
+	}
+	return nil
+}
+
+// GetByID Returns the folio along with its entries in posting order
+func (r *FolioRepository) GetByID(ctx context.Context, id uuid.UUID) (*Folio, error) {
+	var folio Folio
+	err := r.db.NewSelect().
+		Model(&folio).
+		ColumnExpr("f.*").
+		ColumnExpr("r.number AS room_number").
+		Join("JOIN rooms AS r ON r.id = f.room_id").
+		Relation("Entries", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("created_at ASC", "id ASC")
+		}).
+		Where("f.id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &folio, nil
+}
+
+func (r *FolioRepository) GetByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters FolioFilters, page pagination.Params,
+) (Folios, string, error) {
+	var folios Folios
+	q := r.db.NewSelect().
+		Model(&folios).
+		Where("hotel_id = ?", hotelID)
+
+	if filters.Status != "" {
+		q = q.Where("status = ?", filters.Status)
+	}
+	if filters.RoomID != nil {
+		q = q.Where("room_id = ?", *filters.RoomID)
+	}
+
+	err := page.Apply(q).Scan(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	folios, nextCursor := pagination.Paginate(folios, page, cursorKey)
+
+	return folios, nextCursor, nil
+}
+
+// touch Bumps the update time of the open folio, failing when it was closed
+// or changed since it was read
+func touch(ctx context.Context, tx bun.Tx, folio *Folio, now time.Time) error {
+	res, err := tx.NewUpdate().
+		Model((*Folio)(nil)).
+		Set("updated_at = ?", now).
+		Where("id = ?", folio.ID).
+		Where("status = ?", OPEN).
+		Where("updated_at = ?", folio.UpdatedAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrFolioChanged
+	}
+	folio.UpdatedAt = now
+	return nil
+}
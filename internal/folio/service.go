@@ -0,0 +1,375 @@
+package folio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/guest"
+	"github.com/sebenitezg/hotel-service/internal/hotel"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+type FolioService struct {
+	folioRepo       *FolioRepository
+	hotelService    *hotel.HotelService
+	roomService     *room.RoomService
+	roomTypeService *roomtype.RoomTypeService
+	guestService    *guest.GuestService
+	log             *zap.SugaredLogger
+}
+
+func NewService(
+	folioRepo *FolioRepository,
+	hotelService *hotel.HotelService,
+	roomService *room.RoomService,
+	roomTypeService *roomtype.RoomTypeService,
+	guestService *guest.GuestService,
+) *FolioService {
+	return &FolioService{
+		folioRepo:       folioRepo,
+		hotelService:    hotelService,
+		roomService:     roomService,
+		roomTypeService: roomTypeService,
+		guestService:    guestService,
+		log:             logger.GetLogger(),
+	}
+}
+
+// OpenFolio Opens the folio against a room of the hotel, its amounts are in
+// the currency of the room's room type. Folios without a guest profile get a
+// new one with the guest name.
+func (s *FolioService) OpenFolio(ctx context.Context, f *Folio) (*Folio, error) {
+	if !f.EndDate.After(f.StartDate) || f.EndDate.After(f.StartDate.AddDate(0, 0, MaxNights)) {
+		return nil, ErrInvalidFolioDates
+	}
+
+	_, rt, err := s.retrieveRoom(ctx, f.HotelID, f.RoomID, false)
+	if err != nil {
+		return nil, err
+	}
+	f.Currency = rt.Currency
+
+	if f.GuestID.IsNil() {
+		if f.GuestID, err = s.guestService.FindOrCreateGuest(ctx, f.HotelID, f.GuestName, ""); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.guestService.RetrieveGuest(ctx, f.HotelID, f.GuestID); err != nil {
+		return nil, err
+	}
+
+	if err := s.folioRepo.Save(ctx, f); err != nil {
+		s.log.Errorw("error saving folio", "roomID", f.RoomID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("folio opened successfully", "folioID", f.ID, "roomID", f.RoomID)
+
+	return s.RetrieveFolio(ctx, f.HotelID, f.ID)
+}
+
+// RetrieveFolio Returns the hotel's folio along with its entries
+func (s *FolioService) RetrieveFolio(ctx context.Context, hotelID uuid.UUID, folioID uuid.UUID) (*Folio, error) {
+	f, err := s.folioRepo.GetByID(ctx, folioID)
+	if err != nil {
+		s.log.Errorw("error retrieving folio", "folioID", folioID, "error", err)
+		return nil, err
+	}
+	if f == nil || f.HotelID != hotelID {
+		return nil, ErrFolioNotFound
+	}
+
+	if err := s.resolveGuests(ctx, hotelID, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *FolioService) ListFolios(
+	ctx context.Context, hotelID uuid.UUID, filters FolioFilters, page pagination.Params,
+) (Folios, string, error) {
+	hotelExist, err := s.hotelService.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return nil, "", err
+	}
+	if !hotelExist {
+		return nil, "", ErrHotelNotFound
+	}
+
+	folios, nextCursor, err := s.folioRepo.GetByHotelID(ctx, hotelID, filters, page)
+	if err != nil {
+		s.log.Errorw("error retrieving folios", "hotelID", hotelID, "error", err)
+		return nil, "", err
+	}
+
+	guestFolios := make([]*Folio, len(folios))
+	for i := range folios {
+		guestFolios[i] = &folios[i]
+	}
+	if err := s.resolveGuests(ctx, hotelID, guestFolios...); err != nil {
+		return nil, "", err
+	}
+	return folios, nextCursor, nil
+}
+
+// PostRoomCharges Charges the room type's base price for every night of the
+// folio up to today that was not charged yet. Nights already charged are
+// skipped, so the night audit can run it as many times as needed.
+func (s *FolioService) PostRoomCharges(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, folioID uuid.UUID,
+) (*Folio, error) {
+	f, err := s.retrieveOpenFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+
+	nights := f.UnpostedNights(time.Now().UTC())
+	if len(nights) == 0 {
+		return f, nil
+	}
+
+	// Rooms and room types deleted during the stay are still charged
+	r, rt, err := s.retrieveRoom(ctx, hotelID, f.RoomID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("Room %d - %s", r.Number, rt.Name)
+	price := currency.Round(rt.BasePrice, f.Currency)
+
+	entries := make(Entries, 0, len(nights))
+	for _, night := range nights {
+		entry, err := NewEntry(f.ID, ROOM_CHARGE, "room", description, night, price, "", actor.Principal)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+
+	if err := s.folioRepo.Post(ctx, f, entries); err != nil {
+		s.log.Errorw("error posting room charges", "folioID", folioID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("room charges posted successfully", "folioID", folioID, "nights", len(entries))
+
+	return s.RetrieveFolio(ctx, hotelID, folioID)
+}
+
+// PostCharge Posts an incidental charge such as the minibar or the
+// restaurant. Charges without a date are posted on today's date, or on the
+// nearest night of the folio when today is outside of it.
+func (s *FolioService) PostCharge(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	folioID uuid.UUID,
+	category string,
+	description string,
+	amount decimal.Decimal,
+	date *time.Time,
+) (*Folio, error) {
+	if !amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	f, err := s.retrieveOpenFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+
+	chargeDate := postingDate(f)
+	if date != nil {
+		if !f.Covers(*date) {
+			return nil, ErrDateOutOfRange
+		}
+		chargeDate = *date
+	}
+
+	entry, err := NewEntry(f.ID, CHARGE, category, description, chargeDate, amount, "", actor.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, f, entry)
+}
+
+// PostPayment Posts a payment of the guest settling the balance
+func (s *FolioService) PostPayment(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	folioID uuid.UUID,
+	method string,
+	amount decimal.Decimal,
+	reference string,
+) (*Folio, error) {
+	if !amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	f, err := s.retrieveOpenFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := NewEntry(f.ID, PAYMENT, method, "Payment", postingDate(f), amount, reference, actor.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, f, entry)
+}
+
+// PostRefund Posts money returned to the guest, at most what the guest paid
+// and was not refunded yet
+func (s *FolioService) PostRefund(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	folioID uuid.UUID,
+	method string,
+	amount decimal.Decimal,
+	reference string,
+) (*Folio, error) {
+	if !amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	f, err := s.retrieveOpenFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := f.Totals()
+	if amount.GreaterThan(totals.Payments.Sub(totals.Refunds)) {
+		return nil, ErrRefundExceedsPayments
+	}
+
+	entry, err := NewEntry(f.ID, REFUND, method, "Refund", postingDate(f), amount, reference, actor.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, f, entry)
+}
+
+// CloseFolio Closes the folio once its balance is settled, closed folios
+// do not take any more entries
+func (s *FolioService) CloseFolio(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, folioID uuid.UUID,
+) (*Folio, error) {
+	f, err := s.retrieveOpenFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+	if !f.Totals().Balance.IsZero() {
+		return nil, ErrBalanceNotSettled
+	}
+
+	f.ClosedAt = time.Now().UTC()
+	f.ClosedBy = actor.Principal
+	if err := s.folioRepo.Close(ctx, f); err != nil {
+		s.log.Errorw("error closing folio", "folioID", folioID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("folio closed successfully", "folioID", folioID)
+
+	return s.RetrieveFolio(ctx, hotelID, folioID)
+}
+
+// CreateInvoice Returns the invoice of the folio as of now
+func (s *FolioService) CreateInvoice(ctx context.Context, hotelID uuid.UUID, folioID uuid.UUID) (*Invoice, error) {
+	h, err := s.hotelService.GetHotelByID(ctx, hotelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.RetrieveFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewInvoice(h, f, time.Now().UTC()), nil
+}
+
+func (s *FolioService) post(ctx context.Context, f *Folio, entry *Entry) (*Folio, error) {
+	if err := s.folioRepo.Post(ctx, f, Entries{*entry}); err != nil {
+		s.log.Errorw("error posting folio entry", "folioID", f.ID, "kind", entry.Kind, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("folio entry posted successfully", "folioID", f.ID, "kind", entry.Kind, "entryID", entry.ID)
+
+	return s.RetrieveFolio(ctx, f.HotelID, f.ID)
+}
+
+func (s *FolioService) retrieveOpenFolio(ctx context.Context, hotelID uuid.UUID, folioID uuid.UUID) (*Folio, error) {
+	f, err := s.RetrieveFolio(ctx, hotelID, folioID)
+	if err != nil {
+		return nil, err
+	}
+	if f.Status != string(OPEN) {
+		return nil, ErrFolioClosed
+	}
+	return f, nil
+}
+
+// resolveGuests Reads the guest names of the hotel's folios from their guest
+// profiles
+func (s *FolioService) resolveGuests(ctx context.Context, hotelID uuid.UUID, folios ...*Folio) error {
+	guestIDs := make([]uuid.UUID, 0, len(folios))
+	for _, f := range folios {
+		if !f.GuestID.IsNil() {
+			guestIDs = append(guestIDs, f.GuestID)
+		}
+	}
+
+	contacts, err := s.guestService.ResolveGuestContacts(ctx, hotelID, guestIDs)
+	if err != nil {
+		return err
+	}
+	for _, f := range folios {
+		f.GuestName = contacts[f.GuestID].Name
+	}
+	return nil
+}
+
+// retrieveRoom Returns the hotel's room along with its room type
+func (s *FolioService) retrieveRoom(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, includeDeleted bool,
+) (*room.Room, *roomtype.RoomType, error) {
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+	rt, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, r.RoomTypeID, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, rt, nil
+}
+
+// postingDate Date entries posted now are booked on, today or the nearest
+// night of the folio when today is outside of it
+func postingDate(f *Folio) time.Time {
+	today, _ := time.Parse(time.DateOnly, time.Now().UTC().Format(time.DateOnly))
+	if lastNight := f.EndDate.AddDate(0, 0, -1); today.After(lastNight) {
+		return lastNight
+	}
+	if today.Before(f.StartDate) {
+		return f.StartDate
+	}
+	return today
+}
@@ -0,0 +1,86 @@
+package guest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// keySize AES-256 keys are 32 bytes long
+const keySize = 32
+
+// blindIndexLabel derives the blind index key from the encryption key, so a
+// single configured key serves both purposes without being reused as is
+const blindIndexLabel = "hotel-service/guest/blind-index"
+
+var ErrCiphertextTooShort = errors.New("ciphertext is shorter than its nonce")
+
+// Cipher encrypts the personal data of guests with AES-256-GCM and computes
+// the blind indexes used to find guests by exact values without decrypting
+// them.
+type Cipher struct {
+	aead     cipher.AEAD
+	indexKey []byte
+}
+
+// NewCipher Returns the cipher of the base64 encoded 32 bytes key
+func NewCipher(encodedKey string) (*Cipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("guest encryption key is not base64: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("guest encryption key must be %d bytes long, got %d", keySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(blindIndexLabel))
+
+	return &Cipher{
+		aead:     aead,
+		indexKey: mac.Sum(nil),
+	}, nil
+}
+
+// Seal Encrypts plaintext, the random nonce is prepended to the ciphertext
+func (c *Cipher) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open Decrypts a ciphertext returned by Seal
+func (c *Cipher) Open(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < c.aead.NonceSize() {
+		return nil, ErrCiphertextTooShort
+	}
+	nonce, sealed := ciphertext[:c.aead.NonceSize()], ciphertext[c.aead.NonceSize():]
+	return c.aead.Open(nil, nonce, sealed, nil)
+}
+
+// BlindIndex Returns the keyed hash of a normalized value, empty values have
+// no index
+func (c *Cipher) BlindIndex(value string) []byte {
+	if value == "" {
+		return nil
+	}
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,124 @@
+package guest
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type DocumentRequest struct {
+	Type      string `json:"type" validate:"required,oneof=passport id_card driving_license visa other"`
+	Number    string `json:"number" validate:"required,max=64"`
+	Country   string `json:"country" validate:"required,iso3166_1_alpha2"`
+	ExpiresOn string `json:"expires_on" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type CreateGuestRequest struct {
+	FirstName        string            `json:"first_name" validate:"required,max=128"`
+	LastName         string            `json:"last_name" validate:"required,max=128"`
+	Email            string            `json:"email" validate:"omitempty,email,max=256"`
+	Phone            string            `json:"phone" validate:"omitempty,max=32"`
+	Nationality      string            `json:"nationality" validate:"omitempty,iso3166_1_alpha2"`
+	Documents        []DocumentRequest `json:"documents" validate:"max=10,dive"`
+	Preferences      map[string]string `json:"preferences" validate:"max=50"`
+	MarketingConsent bool              `json:"marketing_consent"`
+}
+
+type UpdateGuestRequest struct {
+	FirstName        *string            `json:"first_name" validate:"omitempty,min=1,max=128"`
+	LastName         *string            `json:"last_name" validate:"omitempty,min=1,max=128"`
+	Email            *string            `json:"email" validate:"omitempty,email,max=256"`
+	Phone            *string            `json:"phone" validate:"omitempty,max=32"`
+	Nationality      *string            `json:"nationality" validate:"omitempty,iso3166_1_alpha2"`
+	Documents        *[]DocumentRequest `json:"documents" validate:"omitempty,max=10,dive"`
+	Preferences      *map[string]string `json:"preferences" validate:"omitempty,max=50"`
+	MarketingConsent *bool              `json:"marketing_consent"`
+}
+
+type MergeGuestsRequest struct {
+	DuplicateIDs []uuid.UUID `json:"duplicate_ids" validate:"required,min=1,max=20"`
+}
+
+type DocumentResponse struct {
+	Type      string `json:"type"`
+	Number    string `json:"number"`
+	Country   string `json:"country"`
+	ExpiresOn string `json:"expires_on,omitempty"`
+}
+
+type GuestResponse struct {
+	ID                 uuid.UUID          `json:"id"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
+	HotelID            uuid.UUID          `json:"hotel_id"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Email              string             `json:"email,omitempty"`
+	Phone              string             `json:"phone,omitempty"`
+	Nationality        string             `json:"nationality,omitempty"`
+	Documents          []DocumentResponse `json:"documents"`
+	Preferences        map[string]string  `json:"preferences,omitempty"`
+	MarketingConsent   bool               `json:"marketing_consent"`
+	MarketingConsentAt string             `json:"marketing_consent_at,omitempty"`
+	MergedInto         *uuid.UUID         `json:"merged_into,omitempty"`
+}
+
+type ListGuestsResponse struct {
+	Results    []GuestResponse `json:"results"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+// NewDocuments Returns the documents of the request
+func NewDocuments(requests []DocumentRequest) []Document {
+	documents := make([]Document, len(requests))
+	for i, d := range requests {
+		documents[i] = Document{
+			Type:      d.Type,
+			Number:    d.Number,
+			Country:   d.Country,
+			ExpiresOn: d.ExpiresOn,
+		}
+	}
+	return documents
+}
+
+func NewGuestResponse(g *Guest) GuestResponse {
+	resp := GuestResponse{
+		ID:               g.ID,
+		CreatedAt:        g.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        g.UpdatedAt.Format(time.RFC3339),
+		HotelID:          g.HotelID,
+		FirstName:        g.FirstName,
+		LastName:         g.LastName,
+		Email:            g.Email,
+		Phone:            g.Phone,
+		Nationality:      g.Nationality,
+		Documents:        make([]DocumentResponse, len(g.Documents)),
+		Preferences:      g.Preferences,
+		MarketingConsent: g.MarketingConsent,
+		MergedInto:       g.MergedInto,
+	}
+	for i, d := range g.Documents {
+		resp.Documents[i] = DocumentResponse{
+			Type:      d.Type,
+			Number:    d.Number,
+			Country:   d.Country,
+			ExpiresOn: d.ExpiresOn,
+		}
+	}
+	if !g.MarketingConsentAt.IsZero() {
+		resp.MarketingConsentAt = g.MarketingConsentAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func NewListGuestsResponse(guests Guests, nextCursor string) ListGuestsResponse {
+	responses := make([]GuestResponse, len(guests))
+	for i, guest := range guests {
+		responses[i] = NewGuestResponse(&guest)
+	}
+	return ListGuestsResponse{
+		Results:    responses,
+		NextCursor: nextCursor,
+	}
+}
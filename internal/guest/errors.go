@@ -0,0 +1,18 @@
+package guest
+
+import (
+	"errors"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound      = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrGuestNotFound      = terrors.NotFound("guest", "guest not found", nil)
+	ErrMissingSearchQuery = terrors.BadRequest("query", "search by at least one of name, email or phone", nil)
+	ErrGuestMerged        = core.Conflict("guest_merged", "guest was merged into another profile", nil)
+	ErrMergeIntoItself    = terrors.BadRequest("duplicate_ids", "a guest can not be merged into itself", nil)
+	ErrPlaintextLinkBusy  = errors.New("plaintext guests are being linked by another process")
+)
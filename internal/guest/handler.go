@@ -0,0 +1,282 @@
+package guest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/internal/reservation"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type GuestController struct {
+	validator    *validator.Validate
+	guestService *GuestService
+	log          *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	guestService *GuestService,
+	membershipChecker auth.HotelMembershipChecker,
+) *GuestController {
+	c := &GuestController{
+		validator:    validator,
+		guestService: guestService,
+		log:          logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleGuestRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/guests", c.handleSearchGuests)
+		r.Get("/v1/hotels/{hotel_id}/guests/{guest_id}", c.handleGetGuest)
+		r.Get("/v1/hotels/{hotel_id}/guests/{guest_id}/stays", c.handleListGuestStays)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleGuestWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/guests", c.handleCreateGuest)
+		r.Patch("/v1/hotels/{hotel_id}/guests/{guest_id}", c.handleUpdateGuest)
+		r.Delete("/v1/hotels/{hotel_id}/guests/{guest_id}", c.handleDeleteGuest)
+		r.Post("/v1/hotels/{hotel_id}/guests/{guest_id}:merge", c.handleMergeGuests)
+	})
+
+	return c
+}
+
+func (c *GuestController) handleSearchGuests(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	search := Query{
+		Name:  query.Get("name"),
+		Email: query.Get("email"),
+		Phone: query.Get("phone"),
+	}
+
+	guests, nextCursor, err := c.guestService.SearchGuests(r.Context(), hotelID, search, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListGuestsResponse(guests, nextCursor)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *GuestController) handleGetGuest(w http.ResponseWriter, r *http.Request) {
+	hotelID, guestID, ok := c.hotelGuestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	guest, err := c.guestService.RetrieveGuest(r.Context(), hotelID, guestID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewGuestResponse(guest)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *GuestController) handleListGuestStays(w http.ResponseWriter, r *http.Request) {
+	hotelID, guestID, ok := c.hotelGuestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	stays, err := c.guestService.ListStays(r.Context(), hotelID, guestID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := reservation.NewListReservationsResponse(stays)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *GuestController) handleCreateGuest(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CreateGuestRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	guest, err := NewGuest(
+		hotelID,
+		PersonalData{
+			FirstName: payload.FirstName,
+			LastName:  payload.LastName,
+			Email:     payload.Email,
+			Phone:     payload.Phone,
+			Documents: NewDocuments(payload.Documents),
+		},
+		payload.Nationality,
+		payload.Preferences,
+		payload.MarketingConsent,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating guest instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	guest, err = c.guestService.CreateGuest(r.Context(), guest)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewGuestResponse(guest)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *GuestController) handleUpdateGuest(w http.ResponseWriter, r *http.Request) {
+	hotelID, guestID, ok := c.hotelGuestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload UpdateGuestRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	var documents *[]Document
+	if payload.Documents != nil {
+		d := NewDocuments(*payload.Documents)
+		documents = &d
+	}
+
+	guest, err := c.guestService.UpdatePartiallyGuest(
+		r.Context(),
+		hotelID,
+		guestID,
+		payload.FirstName,
+		payload.LastName,
+		payload.Email,
+		payload.Phone,
+		documents,
+		payload.Nationality,
+		payload.Preferences,
+		payload.MarketingConsent,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewGuestResponse(guest)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *GuestController) handleDeleteGuest(w http.ResponseWriter, r *http.Request) {
+	hotelID, guestID, ok := c.hotelGuestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.guestService.DeleteGuest(r.Context(), hotelID, guestID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *GuestController) handleMergeGuests(w http.ResponseWriter, r *http.Request) {
+	hotelID, guestID, ok := c.hotelGuestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload MergeGuestsRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	guest, err := c.guestService.MergeGuests(r.Context(), hotelID, guestID, payload.DuplicateIDs)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewGuestResponse(guest)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *GuestController) hotelID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return uuid.Nil, false
+	}
+	return uuidHotelID, true
+}
+
+func (c *GuestController) hotelGuestIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	guestID := chi.URLParam(r, "guest_id")
+	uuidGuestID, err := uuid.FromString(guestID)
+	if err != nil {
+		c.log.Errorw("invalid guest id", "guestID", guestID, "error", err)
+		rest.RenderError(r.Context(), w, ErrGuestNotFound)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return hotelID, uuidGuestID, true
+}
+
+// decode Reads and validates the request body into payload
+func (c *GuestController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,258 @@
+package guest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+// --------------------
+// DB models
+// --------------------
+
+// Guest profile of a person staying at a hotel. The personal data is only
+// stored encrypted in PII, EmailIndex, PhoneIndex and NameIndex are blind
+// indexes used to deduplicate and search guests.
+type Guest struct {
+	bun.BaseModel      `bun:"table:guests"`
+	ID                 uuid.UUID         `bun:"id,pk"`
+	CreatedAt          time.Time         `bun:"created_at"`
+	UpdatedAt          time.Time         `bun:"updated_at"`
+	HotelID            uuid.UUID         `bun:"hotel_id"`
+	Nationality        string            `bun:"nationality"`
+	Preferences        map[string]string `bun:"preferences,type:jsonb"`
+	MarketingConsent   bool              `bun:"marketing_consent"`
+	MarketingConsentAt time.Time         `bun:"marketing_consent_at,nullzero"`
+	MergedInto         *uuid.UUID        `bun:"merged_into"`
+	PII                []byte            `bun:"pii"`
+	EmailIndex         []byte            `bun:"email_index"`
+	PhoneIndex         []byte            `bun:"phone_index"`
+	NameIndex          []string          `bun:"name_index,array"`
+
+	PersonalData `bun:"-"`
+}
+
+// PersonalData contact and identity data of a guest, stored encrypted
+type PersonalData struct {
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	Email     string     `json:"email"`
+	Phone     string     `json:"phone"`
+	Documents []Document `json:"documents"`
+}
+
+// Document identity document of a guest
+type Document struct {
+	Type      string `json:"type"`
+	Number    string `json:"number"`
+	Country   string `json:"country"`
+	ExpiresOn string `json:"expires_on,omitempty"`
+}
+
+type Guests []Guest
+
+// Query finds guests by whole words of their name, their email or their
+// phone, empty fields are ignored
+type Query struct {
+	Name  string
+	Email string
+	Phone string
+}
+
+// plaintextTable table that stored the names and emails of guests in clear
+// text before linking its rows to guest profiles, along with the expressions
+// its guest and email are read with
+type plaintextTable struct {
+	name       string
+	guestID    string
+	guestEmail string
+}
+
+// plaintextTables are linked in order, stays take the guest of their
+// reservation once it is linked
+var plaintextTables = []plaintextTable{
+	{name: "reservations", guestID: "guest_id", guestEmail: "COALESCE(guest_email, '')"},
+	{
+		name:       "stays",
+		guestID:    "COALESCE(guest_id, (SELECT res.guest_id FROM reservations AS res WHERE res.id = reservation_id))",
+		guestEmail: "''",
+	},
+	{name: "folios", guestID: "guest_id", guestEmail: "''"},
+}
+
+// plaintextGuest guest of a row stored in clear text
+type plaintextGuest struct {
+	ID         uuid.UUID  `bun:"id"`
+	HotelID    uuid.UUID  `bun:"hotel_id"`
+	GuestID    *uuid.UUID `bun:"guest_id"`
+	GuestName  string     `bun:"guest_name"`
+	GuestEmail string     `bun:"guest_email"`
+}
+
+// SortableColumns public sort keys of guest listings and their columns
+var SortableColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+const DefaultSort = "-updated_at"
+
+func cursorKey(guest Guest, column string) (string, uuid.UUID) {
+	if column == "created_at" {
+		return guest.CreatedAt.Format(time.RFC3339Nano), guest.ID
+	}
+	return guest.UpdatedAt.Format(time.RFC3339Nano), guest.ID
+}
+
+func NewGuest(
+	hotelID uuid.UUID,
+	data PersonalData,
+	nationality string,
+	preferences map[string]string,
+	marketingConsent bool,
+) (*Guest, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Guest{
+		ID:           id,
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+		HotelID:      hotelID,
+		Nationality:  strings.ToUpper(nationality),
+		Preferences:  preferences,
+		PersonalData: data,
+	}
+	g.SetMarketingConsent(marketingConsent)
+
+	return g, nil
+}
+
+// SetMarketingConsent Records when the guest gave their consent
+func (g *Guest) SetMarketingConsent(consent bool) {
+	if consent && !g.MarketingConsent {
+		g.MarketingConsentAt = time.Now().UTC()
+	}
+	if !consent {
+		g.MarketingConsentAt = time.Time{}
+	}
+	g.MarketingConsent = consent
+}
+
+// Seal Encrypts the personal data of the guest and computes its blind
+// indexes
+func (g *Guest) Seal(c *Cipher) error {
+	plaintext, err := json.Marshal(g.PersonalData)
+	if err != nil {
+		return err
+	}
+	if g.PII, err = c.Seal(plaintext); err != nil {
+		return err
+	}
+
+	g.EmailIndex = c.BlindIndex(NormalizeEmail(g.Email))
+	g.PhoneIndex = c.BlindIndex(NormalizePhone(g.Phone))
+	g.NameIndex = NameIndex(c, g.FirstName+" "+g.LastName)
+	return nil
+}
+
+// Open Decrypts the personal data of the guest
+func (g *Guest) Open(c *Cipher) error {
+	plaintext, err := c.Open(g.PII)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(plaintext, &g.PersonalData)
+}
+
+// Merge Completes the guest with the data of a duplicate profile, the
+// guest's own data wins over the duplicate's
+func (g *Guest) Merge(duplicate *Guest) {
+	if g.FirstName == "" && g.LastName == "" {
+		g.FirstName, g.LastName = duplicate.FirstName, duplicate.LastName
+	}
+	if g.Email == "" {
+		g.Email = duplicate.Email
+	}
+	if g.Phone == "" {
+		g.Phone = duplicate.Phone
+	}
+	if g.Nationality == "" {
+		g.Nationality = duplicate.Nationality
+	}
+	for _, document := range duplicate.Documents {
+		if !slices.ContainsFunc(g.Documents, document.sameAs) {
+			g.Documents = append(g.Documents, document)
+		}
+	}
+	for key, value := range duplicate.Preferences {
+		if _, ok := g.Preferences[key]; !ok {
+			if g.Preferences == nil {
+				g.Preferences = make(map[string]string)
+			}
+			g.Preferences[key] = value
+		}
+	}
+}
+
+// FullName Returns the first and last names of the guest
+func (g *Guest) FullName() string {
+	return strings.TrimSpace(g.FirstName + " " + g.LastName)
+}
+
+// SplitName Splits a full name into its first word and the rest, the last
+// name
+func SplitName(name string) (string, string) {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return "", ""
+	}
+	return words[0], strings.Join(words[1:], " ")
+}
+
+func (d Document) sameAs(other Document) bool {
+	return d.Type == other.Type && d.Country == other.Country && d.Number == other.Number
+}
+
+// NormalizeEmail Returns the form emails are deduplicated and searched by
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone Returns the digits of the phone, keeping its leading plus
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+	var b strings.Builder
+	for i, r := range phone {
+		if unicode.IsDigit(r) || (i == 0 && r == '+') {
+			b.WriteRune(r)
+		}
+	}
+	if b.String() == "+" {
+		return ""
+	}
+	return b.String()
+}
+
+// NameIndex Returns the blind indexes of the lower cased words of a name
+func NameIndex(c *Cipher, name string) []string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	slices.Sort(words)
+	words = slices.Compact(words)
+
+	index := make([]string, len(words))
+	for i, word := range words {
+		index[i] = hex.EncodeToString(c.BlindIndex(word))
+	}
+	return index
+}
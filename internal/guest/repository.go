@@ -0,0 +1,280 @@
+package guest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sebenitezg/hotel-service/internal/reservation"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// uniqueViolation is the Postgres error code raised by the
+// guests_hotel_email_idx index.
+const uniqueViolation = "23505"
+
+// plaintextLockKey key of the advisory lock held while linking plaintext
+// guests, so concurrent runs can not create duplicate profiles
+const plaintextLockKey = 7340011
+
+// errDuplicateEmail another active guest of the hotel has the same email
+var errDuplicateEmail = errors.New("guest email already exists")
+
+type GuestRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *GuestRepository {
+	return &GuestRepository{
+		db: db,
+	}
+}
+
+func (r *GuestRepository) Save(ctx context.Context, guest *Guest) error {
+	_, err := r.db.NewInsert().
+		Model(guest).
+		Exec(ctx)
+	return duplicateEmailError(err)
+}
+
+func (r *GuestRepository) Update(ctx context.Context, guest *Guest) error {
+	_, err := r.db.NewUpdate().
+		Model(guest).
+		WherePK().
+		Exec(ctx)
+	return duplicateEmailError(err)
+}
+
+func (r *GuestRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Profiles merged into the guest go along with it
+		_, err := tx.NewDelete().
+			Model((*Guest)(nil)).
+			Where("merged_into = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.NewDelete().
+			Model((*Guest)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *GuestRepository) GetByID(ctx context.Context, id uuid.UUID) (*Guest, error) {
+	var guest Guest
+	err := r.db.NewSelect().
+		Model(&guest).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// GetByEmailIndex Returns the hotel's active guest with the email
+func (r *GuestRepository) GetByEmailIndex(ctx context.Context, hotelID uuid.UUID, emailIndex []byte) (*Guest, error) {
+	var guest Guest
+	err := r.db.NewSelect().
+		Model(&guest).
+		Where("hotel_id = ?", hotelID).
+		Where("email_index = ?", emailIndex).
+		Where("merged_into IS NULL").
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// GetMerged Returns the guests merged into the guest
+func (r *GuestRepository) GetMerged(ctx context.Context, id uuid.UUID) (Guests, error) {
+	var guests Guests
+	err := r.db.NewSelect().
+		Model(&guests).
+		Where("merged_into = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+// Search Returns a page of the hotel's active guests matching every given
+// blind index along with the cursor of the next page
+func (r *GuestRepository) Search(
+	ctx context.Context, hotelID uuid.UUID, emailIndex, phoneIndex []byte, nameIndex []string, page pagination.Params,
+) (Guests, string, error) {
+	var guests Guests
+	q := r.db.NewSelect().
+		Model(&guests).
+		Where("hotel_id = ?", hotelID).
+		Where("merged_into IS NULL")
+
+	if emailIndex != nil {
+		q = q.Where("email_index = ?", emailIndex)
+	}
+	if phoneIndex != nil {
+		q = q.Where("phone_index = ?", phoneIndex)
+	}
+	if len(nameIndex) > 0 {
+		q = q.Where("name_index @> ?", pgdialect.Array(nameIndex))
+	}
+
+	err := page.Apply(q).Scan(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	guests, nextCursor := pagination.Paginate(guests, page, cursorKey)
+
+	return guests, nextCursor, nil
+}
+
+// Merge Marks the duplicates, and the guests already merged into them, as
+// merged into the guest and stores the completed guest
+func (r *GuestRepository) Merge(ctx context.Context, guest *Guest, duplicateIDs []uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Duplicates stop being active first, so the guest can take over
+		// their email
+		_, err := tx.NewUpdate().
+			Model((*Guest)(nil)).
+			Set("merged_into = ?", guest.ID).
+			Set("updated_at = ?", guest.UpdatedAt).
+			Where("id IN (?) OR merged_into IN (?)", bun.In(duplicateIDs), bun.In(duplicateIDs)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model(guest).
+			WherePK().
+			Exec(ctx)
+		return duplicateEmailError(err)
+	})
+}
+
+// GetByIDs Returns the hotel's guests found by their IDs, merged ones
+// included
+func (r *GuestRepository) GetByIDs(ctx context.Context, hotelID uuid.UUID, ids []uuid.UUID) (Guests, error) {
+	var guests Guests
+	if len(ids) == 0 {
+		return guests, nil
+	}
+
+	err := r.db.NewSelect().
+		Model(&guests).
+		Where("hotel_id = ?", hotelID).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+// GetStays Returns the hotel's reservations of any of the guests
+func (r *GuestRepository) GetStays(
+	ctx context.Context, hotelID uuid.UUID, guestIDs []uuid.UUID,
+) (reservation.Reservations, error) {
+	var stays reservation.Reservations
+	if len(guestIDs) == 0 {
+		return stays, nil
+	}
+
+	err := r.db.NewSelect().
+		Model(&stays).
+		Where("hotel_id = ?", hotelID).
+		Where("guest_id IN (?)", bun.In(guestIDs)).
+		Order("check_in DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stays, nil
+}
+
+// LockPlaintextGuests Takes the advisory lock of the linking of plaintext
+// guests on a connection of its own, returning the function releasing it.
+// It reports false when another process holds the lock.
+func (r *GuestRepository) LockPlaintextGuests(ctx context.Context) (func(), bool, error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var locked bool
+	err = conn.NewSelect().ColumnExpr("pg_try_advisory_lock(?)", plaintextLockKey).Scan(ctx, &locked)
+	if err != nil || !locked {
+		_ = conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(?)", plaintextLockKey)
+		_ = conn.Close()
+	}
+	return unlock, true, nil
+}
+
+// GetPlaintextGuests Returns a batch of the rows of the table still storing
+// their guest in clear text
+func (r *GuestRepository) GetPlaintextGuests(
+	ctx context.Context, table plaintextTable, limit int,
+) ([]plaintextGuest, error) {
+	var rows []plaintextGuest
+	err := r.db.NewSelect().
+		TableExpr(table.name).
+		Column("id", "hotel_id").
+		ColumnExpr(table.guestID+" AS guest_id").
+		ColumnExpr("guest_name").
+		ColumnExpr(table.guestEmail+" AS guest_email").
+		Where("guest_name IS NOT NULL").
+		OrderExpr("id ASC").
+		Limit(limit).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// LinkPlaintextGuest Links the row of the table to the guest and clears the
+// guest data it stored in clear text
+func (r *GuestRepository) LinkPlaintextGuest(
+	ctx context.Context, table plaintextTable, id uuid.UUID, guestID uuid.UUID,
+) error {
+	q := r.db.NewUpdate().
+		TableExpr(table.name).
+		Set("guest_id = ?", guestID).
+		Set("guest_name = NULL").
+		Where("id = ?", id)
+	if table.name == "reservations" {
+		q = q.Set("guest_email = NULL")
+	}
+	_, err := q.Exec(ctx)
+	return err
+}
+
+func duplicateEmailError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return errDuplicateEmail
+	}
+	return err
+}
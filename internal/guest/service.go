@@ -0,0 +1,427 @@
+package guest
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/reservation"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+// plaintextBatchSize rows linked to guest profiles per query by
+// LinkPlaintextGuests
+const plaintextBatchSize = 100
+
+type GuestService struct {
+	guestRepo      *GuestRepository
+	hotelValidator core.HotelValidator
+	cipher         *Cipher
+	log            *zap.SugaredLogger
+}
+
+func NewService(guestRepo *GuestRepository, hotelValidator core.HotelValidator, cipher *Cipher) *GuestService {
+	return &GuestService{
+		guestRepo:      guestRepo,
+		hotelValidator: hotelValidator,
+		cipher:         cipher,
+		log:            logger.GetLogger(),
+	}
+}
+
+// CreateGuest Stores the guest profile. Guests are deduplicated by email, a
+// profile with the email of an existing one is rejected pointing at it.
+func (s *GuestService) CreateGuest(ctx context.Context, g *Guest) (*Guest, error) {
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, g.HotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", g.HotelID, "error", err)
+		return nil, err
+	}
+	if !hotelExist {
+		return nil, ErrHotelNotFound
+	}
+
+	if err := g.Seal(s.cipher); err != nil {
+		s.log.Errorw("error encrypting guest personal data", "error", err)
+		return nil, err
+	}
+
+	if err := s.guestRepo.Save(ctx, g); err != nil {
+		if errors.Is(err, errDuplicateEmail) {
+			return nil, s.duplicateEmailError(ctx, g)
+		}
+		s.log.Errorw("error creating new guest", "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("guest created successfully", "guestID", g.ID)
+
+	return g, nil
+}
+
+// RetrieveGuest Returns the hotel's guest with its personal data decrypted
+func (s *GuestService) RetrieveGuest(ctx context.Context, hotelID uuid.UUID, guestID uuid.UUID) (*Guest, error) {
+	g, err := s.guestRepo.GetByID(ctx, guestID)
+	if err != nil {
+		s.log.Errorw("error retrieving guest", "guestID", guestID, "error", err)
+		return nil, err
+	}
+	if g == nil || g.HotelID != hotelID {
+		return nil, ErrGuestNotFound
+	}
+
+	if err := g.Open(s.cipher); err != nil {
+		s.log.Errorw("error decrypting guest personal data", "guestID", guestID, "error", err)
+		return nil, err
+	}
+	return g, nil
+}
+
+// SearchGuests Returns a page of the hotel's guests matching the query. Names
+// match by whole words while emails and phones match exactly, as they are
+// only searchable through their blind indexes.
+func (s *GuestService) SearchGuests(
+	ctx context.Context, hotelID uuid.UUID, query Query, page pagination.Params,
+) (Guests, string, error) {
+	emailIndex := s.cipher.BlindIndex(NormalizeEmail(query.Email))
+	phoneIndex := s.cipher.BlindIndex(NormalizePhone(query.Phone))
+	nameIndex := NameIndex(s.cipher, query.Name)
+	if emailIndex == nil && phoneIndex == nil && len(nameIndex) == 0 {
+		return nil, "", ErrMissingSearchQuery
+	}
+
+	guests, nextCursor, err := s.guestRepo.Search(ctx, hotelID, emailIndex, phoneIndex, nameIndex, page)
+	if err != nil {
+		s.log.Errorw("error searching guests", "hotelID", hotelID, "error", err)
+		return nil, "", err
+	}
+
+	for i := range guests {
+		if err := guests[i].Open(s.cipher); err != nil {
+			s.log.Errorw("error decrypting guest personal data", "guestID", guests[i].ID, "error", err)
+			return nil, "", err
+		}
+	}
+	return guests, nextCursor, nil
+}
+
+func (s *GuestService) UpdatePartiallyGuest(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	guestID uuid.UUID,
+	firstName *string,
+	lastName *string,
+	email *string,
+	phone *string,
+	documents *[]Document,
+	nationality *string,
+	preferences *map[string]string,
+	marketingConsent *bool,
+) (*Guest, error) {
+	g, err := s.RetrieveGuest(ctx, hotelID, guestID)
+	if err != nil {
+		return nil, err
+	}
+	if g.MergedInto != nil {
+		return nil, ErrGuestMerged
+	}
+
+	if firstName != nil {
+		g.FirstName = *firstName
+	}
+	if lastName != nil {
+		g.LastName = *lastName
+	}
+	if email != nil {
+		g.Email = *email
+	}
+	if phone != nil {
+		g.Phone = *phone
+	}
+	if documents != nil {
+		g.Documents = *documents
+	}
+	if nationality != nil {
+		g.Nationality = *nationality
+	}
+	if preferences != nil {
+		g.Preferences = *preferences
+	}
+	if marketingConsent != nil {
+		g.SetMarketingConsent(*marketingConsent)
+	}
+	g.UpdatedAt = time.Now().UTC()
+
+	if err := g.Seal(s.cipher); err != nil {
+		s.log.Errorw("error encrypting guest personal data", "guestID", guestID, "error", err)
+		return nil, err
+	}
+
+	if err := s.guestRepo.Update(ctx, g); err != nil {
+		if errors.Is(err, errDuplicateEmail) {
+			return nil, s.duplicateEmailError(ctx, g)
+		}
+		s.log.Errorw("failure updating guest", "guestID", guestID, "error", err)
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// DeleteGuest Erases the guest and the profiles merged into it
+func (s *GuestService) DeleteGuest(ctx context.Context, hotelID uuid.UUID, guestID uuid.UUID) error {
+	g, err := s.RetrieveGuest(ctx, hotelID, guestID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.guestRepo.Delete(ctx, g.ID); err != nil {
+		s.log.Errorw("failure deleting guest", "guestID", guestID, "error", err)
+		return err
+	}
+
+	s.log.Infow("guest deleted successfully", "guestID", guestID)
+
+	return nil
+}
+
+// MergeGuests Merges duplicate profiles of the hotel into the guest. The
+// guest keeps its own data and is completed with the duplicates', which are
+// no longer found by searches.
+func (s *GuestService) MergeGuests(
+	ctx context.Context, hotelID uuid.UUID, guestID uuid.UUID, duplicateIDs []uuid.UUID,
+) (*Guest, error) {
+	if slices.Contains(duplicateIDs, guestID) {
+		return nil, ErrMergeIntoItself
+	}
+
+	g, err := s.RetrieveGuest(ctx, hotelID, guestID)
+	if err != nil {
+		return nil, err
+	}
+	if g.MergedInto != nil {
+		return nil, ErrGuestMerged
+	}
+
+	for _, duplicateID := range duplicateIDs {
+		duplicate, err := s.RetrieveGuest(ctx, hotelID, duplicateID)
+		if err != nil {
+			return nil, err
+		}
+		if duplicate.MergedInto != nil {
+			return nil, ErrGuestMerged
+		}
+		g.Merge(duplicate)
+	}
+	g.UpdatedAt = time.Now().UTC()
+
+	if err := g.Seal(s.cipher); err != nil {
+		s.log.Errorw("error encrypting guest personal data", "guestID", guestID, "error", err)
+		return nil, err
+	}
+
+	if err := s.guestRepo.Merge(ctx, g, duplicateIDs); err != nil {
+		if errors.Is(err, errDuplicateEmail) {
+			return nil, s.duplicateEmailError(ctx, g)
+		}
+		s.log.Errorw("failure merging guests", "guestID", guestID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("guests merged successfully", "guestID", guestID, "duplicates", len(duplicateIDs))
+
+	return g, nil
+}
+
+// ListStays Returns the hotel's reservations of the guest and of the
+// profiles merged into it
+func (s *GuestService) ListStays(
+	ctx context.Context, hotelID uuid.UUID, guestID uuid.UUID,
+) (reservation.Reservations, error) {
+	g, err := s.RetrieveGuest(ctx, hotelID, guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := s.guestRepo.GetMerged(ctx, g.ID)
+	if err != nil {
+		s.log.Errorw("error retrieving merged guests", "guestID", guestID, "error", err)
+		return nil, err
+	}
+
+	profiles := make(map[uuid.UUID]*Guest, len(merged)+1)
+	profiles[g.ID] = g
+	for i := range merged {
+		if err := merged[i].Open(s.cipher); err != nil {
+			s.log.Errorw("error decrypting guest personal data", "guestID", merged[i].ID, "error", err)
+			return nil, err
+		}
+		profiles[merged[i].ID] = &merged[i]
+	}
+
+	stays, err := s.guestRepo.GetStays(ctx, hotelID, slices.Collect(maps.Keys(profiles)))
+	if err != nil {
+		s.log.Errorw("error retrieving guest stays", "guestID", guestID, "error", err)
+		return nil, err
+	}
+	for i := range stays {
+		profile := profiles[stays[i].GuestID]
+		stays[i].GuestName, stays[i].GuestEmail = profile.FullName(), profile.Email
+	}
+	return stays, nil
+}
+
+// FindOrCreateGuest Returns the hotel's active guest with the email, creating
+// a profile with the name and email when there is none. Guests without an
+// email always get a new profile. The hotel is not validated, callers link
+// the guest to a record of an existing hotel.
+func (s *GuestService) FindOrCreateGuest(
+	ctx context.Context, hotelID uuid.UUID, name string, email string,
+) (uuid.UUID, error) {
+	existing, err := s.findByEmail(ctx, hotelID, email)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if existing != nil {
+		return existing.ID, nil
+	}
+
+	firstName, lastName := SplitName(name)
+	g, err := NewGuest(hotelID, PersonalData{FirstName: firstName, LastName: lastName, Email: email}, "", nil, false)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := g.Seal(s.cipher); err != nil {
+		s.log.Errorw("error encrypting guest personal data", "error", err)
+		return uuid.Nil, err
+	}
+
+	err = s.guestRepo.Save(ctx, g)
+	if errors.Is(err, errDuplicateEmail) {
+		// Created meanwhile by another request
+		existing, err := s.findByEmail(ctx, hotelID, email)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if existing == nil {
+			return uuid.Nil, errDuplicateEmail
+		}
+		return existing.ID, nil
+	}
+	if err != nil {
+		s.log.Errorw("error creating new guest", "error", err)
+		return uuid.Nil, err
+	}
+
+	s.log.Infow("guest created successfully", "guestID", g.ID)
+
+	return g.ID, nil
+}
+
+// ResolveGuestContacts Returns the decrypted names and emails of the hotel's
+// guests found by their IDs, merged ones included
+func (s *GuestService) ResolveGuestContacts(
+	ctx context.Context, hotelID uuid.UUID, ids []uuid.UUID,
+) (map[uuid.UUID]core.GuestContact, error) {
+	guests, err := s.guestRepo.GetByIDs(ctx, hotelID, ids)
+	if err != nil {
+		s.log.Errorw("error retrieving guests", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+
+	contacts := make(map[uuid.UUID]core.GuestContact, len(guests))
+	for i := range guests {
+		if err := guests[i].Open(s.cipher); err != nil {
+			s.log.Errorw("error decrypting guest personal data", "guestID", guests[i].ID, "error", err)
+			return nil, err
+		}
+		contacts[guests[i].ID] = core.GuestContact{Name: guests[i].FullName(), Email: guests[i].Email}
+	}
+	return contacts, nil
+}
+
+// LinkPlaintextGuests Links the reservations, stays and folios that still
+// store the name and email of their guest in clear text to guest profiles,
+// found by email or created, and clears that data. Rows are linked one at a
+// time, so it resumes where it stopped when it fails. A single process links
+// them at a time, the others get ErrPlaintextLinkBusy.
+func (s *GuestService) LinkPlaintextGuests(ctx context.Context) error {
+	unlock, locked, err := s.guestRepo.LockPlaintextGuests(ctx)
+	if err != nil {
+		s.log.Errorw("error locking plaintext guests", "error", err)
+		return err
+	}
+	if !locked {
+		return ErrPlaintextLinkBusy
+	}
+	defer unlock()
+
+	for _, table := range plaintextTables {
+		linked := 0
+		for {
+			rows, err := s.guestRepo.GetPlaintextGuests(ctx, table, plaintextBatchSize)
+			if err != nil {
+				s.log.Errorw("error retrieving plaintext guests", "table", table.name, "error", err)
+				return err
+			}
+			if len(rows) == 0 {
+				break
+			}
+
+			for _, row := range rows {
+				var guestID uuid.UUID
+				if row.GuestID != nil {
+					guestID = *row.GuestID
+				} else if guestID, err = s.FindOrCreateGuest(ctx, row.HotelID, row.GuestName, row.GuestEmail); err != nil {
+					return err
+				}
+				if err := s.guestRepo.LinkPlaintextGuest(ctx, table, row.ID, guestID); err != nil {
+					s.log.Errorw("error linking plaintext guest", "table", table.name, "id", row.ID, "error", err)
+					return err
+				}
+			}
+			linked += len(rows)
+		}
+		if linked > 0 {
+			s.log.Infow("plaintext guests linked to guest profiles", "table", table.name, "rows", linked)
+		}
+	}
+	return nil
+}
+
+// findByEmail Returns the hotel's active guest with the email, nil when there
+// is none or the email is empty
+func (s *GuestService) findByEmail(ctx context.Context, hotelID uuid.UUID, email string) (*Guest, error) {
+	emailIndex := s.cipher.BlindIndex(NormalizeEmail(email))
+	if emailIndex == nil {
+		return nil, nil
+	}
+
+	g, err := s.guestRepo.GetByEmailIndex(ctx, hotelID, emailIndex)
+	if err != nil {
+		s.log.Errorw("error retrieving guest by email", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return g, nil
+}
+
+// duplicateEmailError Conflict pointing at the hotel's guest that already
+// has the guest's email
+func (s *GuestService) duplicateEmailError(ctx context.Context, g *Guest) error {
+	params := map[string]string{}
+	existing, err := s.guestRepo.GetByEmailIndex(ctx, g.HotelID, g.EmailIndex)
+	if err != nil {
+		s.log.Errorw("error retrieving guest by email", "hotelID", g.HotelID, "error", err)
+	}
+	if existing != nil {
+		params["guest_id"] = existing.ID.String()
+	}
+	return core.Conflict("guest_exists", "a guest with the same email already exists", params)
+}
@@ -13,6 +13,9 @@ type CreateHotelRequest struct {
 	State       string `json:"state"`
 	Status      string `json:"status"`
 	Description string `json:"description"`
+	// Currency ISO 4217 code of the hotel's prices, it can not be changed
+	// once the hotel is created
+	Currency string `json:"currency" validate:"omitempty,iso4217"`
 }
 
 type UpdateHotelRequest struct {
@@ -32,14 +35,17 @@ type HotelResponse struct {
 	State       string    `json:"state"`
 	Status      string    `json:"status"`
 	Description string    `json:"description"`
+	Currency    string    `json:"currency"`
+	DeletedAt   string    `json:"deleted_at,omitempty"`
 }
 
 type ListHotelsResponse struct {
-	Results []HotelResponse `json:"results"`
+	Results    []HotelResponse `json:"results"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
 
 func NewHotelResponse(hotel *Hotel) HotelResponse {
-	return HotelResponse{
+	resp := HotelResponse{
 		ID:          hotel.ID,
 		CreatedAt:   hotel.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   hotel.UpdatedAt.Format(time.RFC3339),
@@ -49,15 +55,21 @@ func NewHotelResponse(hotel *Hotel) HotelResponse {
 		State:       hotel.State,
 		Status:      hotel.Status,
 		Description: hotel.Description,
+		Currency:    hotel.Currency,
 	}
+	if !hotel.DeletedAt.IsZero() {
+		resp.DeletedAt = hotel.DeletedAt.Format(time.RFC3339)
+	}
+	return resp
 }
 
-func NewListHotelsResponse(hotels Hotels) ListHotelsResponse {
+func NewListHotelsResponse(hotels Hotels, nextCursor string) ListHotelsResponse {
 	hotelsResponse := make([]HotelResponse, len(hotels))
 	for i, hotel := range hotels {
 		hotelsResponse[i] = NewHotelResponse(&hotel)
 	}
 	return ListHotelsResponse{
-		Results: hotelsResponse,
+		Results:    hotelsResponse,
+		NextCursor: nextCursor,
 	}
 }
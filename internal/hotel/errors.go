@@ -1,7 +1,12 @@
 package hotel
 
-import "errors"
+import (
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
 
 var (
-	ErrHotelNotFound = errors.New("hotel not found")
+	ErrHotelNotFound   = terrors.NotFound("hotel", "hotel not found", nil)
+	ErrHotelNotDeleted = core.Conflict("hotel_not_deleted", "hotel is not deleted", nil)
 )
@@ -0,0 +1,172 @@
+package hotel
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	hotelv1 "github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1"
+	grpcserver "github.com/sebenitezg/hotel-service/pkg/server/grpc"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type HotelGRPCController struct {
+	hotelv1.UnimplementedHotelServiceServer
+	hotelService *HotelService
+	log          *zap.SugaredLogger
+}
+
+func NewGRPCController(
+	server *grpcserver.GRPCServer,
+	hotelService *HotelService,
+	membershipChecker auth.HotelMembershipChecker,
+) *HotelGRPCController {
+	c := &HotelGRPCController{
+		hotelService: hotelService,
+		log:          logger.GetLogger(),
+	}
+
+	hotelv1.RegisterHotelServiceServer(server.Server, c)
+	server.RequireRoles(hotelv1.HotelService_GetHotel_FullMethodName, auth.RoleHotelRead)
+	server.RequireRoles(hotelv1.HotelService_ListHotels_FullMethodName, auth.RoleHotelRead)
+	server.RequireRoles(hotelv1.HotelService_CreateHotel_FullMethodName, auth.RoleHotelWrite)
+	server.RequireRoles(hotelv1.HotelService_UpdateHotel_FullMethodName, auth.RoleHotelWrite)
+	server.RequireRoles(hotelv1.HotelService_DeleteHotel_FullMethodName, auth.RoleHotelWrite)
+	server.RequireHotelMember(hotelv1.HotelService_GetHotel_FullMethodName, membershipChecker)
+	server.RequireHotelMember(hotelv1.HotelService_UpdateHotel_FullMethodName, membershipChecker, string(membership.MANAGER))
+	server.RequireHotelMember(hotelv1.HotelService_DeleteHotel_FullMethodName, membershipChecker, string(membership.MANAGER))
+
+	return c
+}
+
+func (c *HotelGRPCController) GetHotel(ctx context.Context, req *hotelv1.GetHotelRequest) (*hotelv1.Hotel, error) {
+	uuidID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	hotel, err := c.hotelService.GetHotelByID(ctx, uuidID, false)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newHotelMessage(hotel), nil
+}
+
+func (c *HotelGRPCController) ListHotels(ctx context.Context, req *hotelv1.ListHotelsRequest) (*hotelv1.ListHotelsResponse, error) {
+	page, err := pagination.NewParams(
+		int(req.GetLimit()), req.GetCursor(), req.GetSort(), SortableColumns, DefaultSort,
+	)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	filters := HotelFilters{
+		Status:  req.GetStatus(),
+		Country: req.GetCountry(),
+		State:   req.GetState(),
+	}
+
+	// Listing is scoped to the caller's hotels by the service
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	hotels, nextCursor, err := c.hotelService.ListHotels(ctx, principal, filters, page)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	results := make([]*hotelv1.Hotel, len(hotels))
+	for i, hotel := range hotels {
+		results[i] = newHotelMessage(&hotel)
+	}
+
+	return &hotelv1.ListHotelsResponse{Results: results, NextCursor: nextCursor}, nil
+}
+
+func (c *HotelGRPCController) CreateHotel(ctx context.Context, req *hotelv1.CreateHotelRequest) (*hotelv1.Hotel, error) {
+	hotel, err := NewHotel(
+		req.GetName(),
+		req.GetAddress(),
+		req.GetCountry(),
+		req.GetState(),
+		req.GetStatus(),
+		req.GetDescription(),
+		// The gRPC API does not expose currencies yet, hotels use the default
+		"",
+	)
+	if err != nil {
+		c.log.Errorw("failure creating hotel model instance", "error", err)
+		return nil, grpcserver.Error(err)
+	}
+
+	hotel, err = c.hotelService.CreateHotel(ctx, core.ActorFromContext(ctx), hotel)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newHotelMessage(hotel), nil
+}
+
+func (c *HotelGRPCController) UpdateHotel(ctx context.Context, req *hotelv1.UpdateHotelRequest) (*hotelv1.Hotel, error) {
+	uuidID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	hotel, err := c.hotelService.UpdatePartiallyHotel(
+		ctx,
+		core.ActorFromContext(ctx),
+		uuidID,
+		req.Name,
+		req.Address,
+		req.Status,
+		req.Description,
+	)
+	if err == ErrHotelNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newHotelMessage(hotel), nil
+}
+
+func (c *HotelGRPCController) DeleteHotel(ctx context.Context, req *hotelv1.DeleteHotelRequest) (*emptypb.Empty, error) {
+	uuidID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	if err := c.hotelService.DeleteHotel(ctx, core.ActorFromContext(ctx), uuidID, req.GetCascade()); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func newHotelMessage(hotel *Hotel) *hotelv1.Hotel {
+	return &hotelv1.Hotel{
+		Id:          hotel.ID.String(),
+		CreatedAt:   timestamppb.New(hotel.CreatedAt),
+		UpdatedAt:   timestamppb.New(hotel.UpdatedAt),
+		Name:        hotel.Name,
+		Address:     hotel.Address,
+		Country:     hotel.Country,
+		State:       hotel.State,
+		Status:      hotel.Status,
+		Description: hotel.Description,
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
 	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
@@ -24,6 +30,7 @@ func NewController(
 	server *rest.HTTPServer,
 	validator *validator.Validate,
 	hotelService *HotelService,
+	membershipChecker auth.HotelMembershipChecker,
 ) *HotelController {
 	c := &HotelController{
 		validator:    validator,
@@ -32,22 +39,63 @@ func NewController(
 	}
 
 	server.Router.Group(func(r chi.Router) {
-		r.Get("/v1/hotels/{hotel_id}", c.handleGetHotel)
+		r.Use(middleware.RequireRoles(auth.RoleHotelRead))
+		// Listing is scoped to the caller's hotels by the service
 		r.Get("/v1/hotels/", c.handleListHotels)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHotelRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}", c.handleGetHotel)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHotelWrite))
 		r.Post("/v1/hotels/", c.handleCreateHotel)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHotelWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
 		r.Patch("/v1/hotels/{hotel_id}", c.handlePartialUpdateHotel)
 		r.Delete("/v1/hotels/{hotel_id}", c.handleDeleteHotel)
+		r.Post("/v1/hotels/{hotel_id}:restore", c.handleRestoreHotel)
 	})
 
 	return c
 }
 
 func (c *HotelController) handleListHotels(w http.ResponseWriter, r *http.Request) {
-	hotels, err := c.hotelService.ListHotels()
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
-	resp := NewListHotelsResponse(hotels)
+
+	includeDeleted, err := middleware.IncludeDeleted(r)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := HotelFilters{
+		Status:         query.Get("status"),
+		Country:        query.Get("country"),
+		State:          query.Get("state"),
+		IncludeDeleted: includeDeleted,
+	}
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+
+	hotels, nextCursor, err := c.hotelService.ListHotels(r.Context(), principal, filters, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+	resp := NewListHotelsResponse(hotels, nextCursor)
 
 	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
@@ -61,9 +109,16 @@ func (c *HotelController) handleGetHotel(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	hotel, err := c.hotelService.GetHotelByID(uuidID)
+	includeDeleted, err := middleware.IncludeDeleted(r)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	hotel, err := c.hotelService.GetHotelByID(r.Context(), uuidID, includeDeleted)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
 	resp := NewHotelResponse(hotel)
@@ -79,6 +134,7 @@ func (c *HotelController) handleCreateHotel(w http.ResponseWriter, r *http.Reque
 		return
 	}
 	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
 		c.log.Errorw("validation failure", "error", err)
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -91,6 +147,7 @@ func (c *HotelController) handleCreateHotel(w http.ResponseWriter, r *http.Reque
 		payload.State,
 		payload.Status,
 		payload.Description,
+		payload.Currency,
 	)
 	if err != nil {
 		c.log.Errorw("failure creating hotel model instance", "error", err)
@@ -98,7 +155,7 @@ func (c *HotelController) handleCreateHotel(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	hotel, err = c.hotelService.CreateHotel(hotel)
+	hotel, err = c.hotelService.CreateHotel(r.Context(), core.ActorFromContext(r.Context()), hotel)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -122,9 +179,12 @@ func (c *HotelController) handlePartialUpdateHotel(w http.ResponseWriter, r *htt
 	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		c.log.Errorw("failed to decode request body", "error", err)
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
 	hotel, err := c.hotelService.UpdatePartiallyHotel(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
 		uuidID,
 		payload.Name,
 		payload.Address,
@@ -133,6 +193,7 @@ func (c *HotelController) handlePartialUpdateHotel(w http.ResponseWriter, r *htt
 	)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
 	resp := NewHotelResponse(hotel)
@@ -142,5 +203,40 @@ func (c *HotelController) handlePartialUpdateHotel(w http.ResponseWriter, r *htt
 }
 
 func (c *HotelController) handleDeleteHotel(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "hotel_id")
+	uuidID, err := uuid.FromString(id)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", id, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	cascade := r.URL.Query().Get("cascade") == "true"
+
+	if err := c.hotelService.DeleteHotel(r.Context(), core.ActorFromContext(r.Context()), uuidID, cascade); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
 
+func (c *HotelController) handleRestoreHotel(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "hotel_id")
+	uuidID, err := uuid.FromString(id)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", id, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	hotel, err := c.hotelService.RestoreHotel(r.Context(), core.ActorFromContext(r.Context()), uuidID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewHotelResponse(hotel)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
@@ -3,6 +3,8 @@ package hotel
 import (
 	"time"
 
+	"github.com/sebenitezg/hotel-service/internal/currency"
+
 	"github.com/gofrs/uuid/v5"
 	"github.com/uptrace/bun"
 )
@@ -21,10 +23,50 @@ type Hotel struct {
 	State         string    `bun:"state"`
 	Status        string    `bun:"status"`
 	Description   string    `bun:"description"`
+	Currency      string    `bun:"currency"`
+	DeletedAt     time.Time `bun:"deleted_at,soft_delete,nullzero"`
 }
 
 type Hotels []Hotel
 
+// HotelDependents number of rows referencing a hotel
+type HotelDependents struct {
+	RoomTypes    int `bun:"room_types"`
+	Rooms        int `bun:"rooms"`
+	Reservations int `bun:"reservations"`
+	Stays        int `bun:"stays"`
+	Folios       int `bun:"folios"`
+}
+
+// HotelFilters narrows down hotel listings, empty fields are ignored
+type HotelFilters struct {
+	// IDs restricts the listing to the given hotels when not nil
+	IDs            []uuid.UUID
+	Status         string
+	Country        string
+	State          string
+	IncludeDeleted bool
+}
+
+// SortableColumns public sort keys of hotel listings and their columns
+var SortableColumns = map[string]string{
+	"name":       "name",
+	"country":    "country",
+	"created_at": "created_at",
+}
+
+const DefaultSort = "created_at"
+
+func cursorKey(hotel Hotel, column string) (string, uuid.UUID) {
+	switch column {
+	case "name":
+		return hotel.Name, hotel.ID
+	case "country":
+		return hotel.Country, hotel.ID
+	}
+	return hotel.CreatedAt.Format(time.RFC3339Nano), hotel.ID
+}
+
 func NewHotel(
 	name string,
 	address string,
@@ -32,6 +74,7 @@ func NewHotel(
 	state string,
 	status string,
 	description string,
+	hotelCurrency string,
 ) (*Hotel, error) {
 	now := time.Now().UTC()
 
@@ -40,6 +83,11 @@ func NewHotel(
 		return nil, err
 	}
 
+	// Every price of the hotel is denominated in its currency
+	if hotelCurrency == "" {
+		hotelCurrency = currency.DefaultCurrency
+	}
+
 	return &Hotel{
 		ID:          id,
 		CreatedAt:   now,
@@ -50,5 +98,6 @@ func NewHotel(
 		State:       state,
 		Status:      status,
 		Description: description,
+		Currency:    hotelCurrency,
 	}, nil
 }
@@ -3,6 +3,12 @@ package hotel
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/audit"
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/uptrace/bun"
@@ -18,42 +24,194 @@ func NewRepository(db *bun.DB) *HotelRepository {
 	}
 }
 
-func (r *HotelRepository) Save(hotel *Hotel) error {
-	_, err := r.db.NewInsert().Model(hotel).Exec(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Save Creates the hotel and stores its audit record and the events in the
+// outbox within the same transaction
+func (r *HotelRepository) Save(
+	ctx context.Context, hotel *Hotel, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(hotel).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// Update Saves the hotel changes and stores their audit record and the
+// events in the outbox within the same transaction
+func (r *HotelRepository) Update(
+	ctx context.Context, hotel *Hotel, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().Model(hotel).Where("id = ?", hotel.ID).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// Delete Soft deletes the hotel. When cascade is set the hotel's room types
+// and rooms are soft deleted too, in the same transaction and with the same
+// deletion time so Restore can bring them back together. The audit record
+// and the events in the outbox are stored within that transaction.
+func (r *HotelRepository) Delete(
+	ctx context.Context, id uuid.UUID, cascade bool, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	deletedAt := time.Now().UTC()
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if cascade {
+			_, err := tx.NewUpdate().
+				Table("rooms").
+				Set("deleted_at = ?", deletedAt).
+				Where("hotel_id = ? AND deleted_at IS NULL", id).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			_, err = tx.NewUpdate().
+				Table("room_types").
+				Set("deleted_at = ?", deletedAt).
+				Where("hotel_id = ? AND deleted_at IS NULL", id).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.NewUpdate().
+			Model((*Hotel)(nil)).
+			Set("deleted_at = ?", deletedAt).
+			Where("id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
 }
 
-func (r *HotelRepository) Update(hotel *Hotel) error {
-	_, err := r.db.NewUpdate().Model(hotel).Where("id = ?", hotel.ID).Exec(context.Background())
+// Restore Undoes the soft deletion of the hotel along with the room types
+// and rooms deleted with it, storing the audit record and the events in the
+// outbox
+func (r *HotelRepository) Restore(
+	ctx context.Context, hotel *Hotel, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Table("room_types").
+			Set("deleted_at = NULL").
+			Where("hotel_id = ? AND deleted_at = ?", hotel.ID, hotel.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.NewUpdate().
+			Table("rooms").
+			Set("deleted_at = NULL").
+			Where("hotel_id = ? AND deleted_at = ?", hotel.ID, hotel.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*Hotel)(nil)).
+			Set("deleted_at = NULL").
+			Where("id = ?", hotel.ID).
+			WhereDeleted().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// CountDependents Counts the live rows referencing the hotel that block its
+// deletion
+func (r *HotelRepository) CountDependents(ctx context.Context, id uuid.UUID) (HotelDependents, error) {
+	var dependents HotelDependents
+	err := r.db.NewSelect().
+		ColumnExpr("(SELECT COUNT(*) FROM room_types WHERE hotel_id = ? AND deleted_at IS NULL) AS room_types", id).
+		ColumnExpr("(SELECT COUNT(*) FROM rooms WHERE hotel_id = ? AND deleted_at IS NULL) AS rooms", id).
+		ColumnExpr(
+			"(SELECT COUNT(*) FROM reservations WHERE hotel_id = ? AND status <> 'cancelled' AND check_out > CURRENT_DATE) AS reservations",
+			id,
+		).
+		ColumnExpr("(SELECT COUNT(*) FROM stays WHERE hotel_id = ? AND checked_out_at IS NULL) AS stays", id).
+		ColumnExpr("(SELECT COUNT(*) FROM folios WHERE hotel_id = ? AND status = 'open') AS folios", id).
+		Scan(ctx, &dependents)
 	if err != nil {
-		return err
+		return HotelDependents{}, err
 	}
-	return nil
+	return dependents, nil
 }
 
-func (r *HotelRepository) Delete(id string) error {
-	_, err := r.db.NewDelete().Model((*Hotel)(nil)).Where("id = ?", id).Exec(context.Background())
+// GetAll Returns a page of the hotels matching the filters along with the
+// cursor of the next page
+func (r *HotelRepository) GetAll(
+	ctx context.Context, filters HotelFilters, page pagination.Params,
+) (Hotels, string, error) {
+	var hotels Hotels
+	q := r.db.NewSelect().Model(&hotels)
+
+	if filters.IncludeDeleted {
+		q = q.WhereAllWithDeleted()
+	}
+	if filters.IDs != nil {
+		q = q.Where("id IN (?)", bun.In(filters.IDs))
+	}
+	if filters.Status != "" {
+		q = q.Where("status = ?", filters.Status)
+	}
+	if filters.Country != "" {
+		q = q.Where("country = ?", filters.Country)
+	}
+	if filters.State != "" {
+		q = q.Where("state = ?", filters.State)
+	}
+
+	err := page.Apply(q).Scan(ctx)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	return nil
+
+	hotels, nextCursor := pagination.Paginate(hotels, page, cursorKey)
+
+	return hotels, nextCursor, nil
 }
 
-func (r *HotelRepository) GetAll() (Hotels, error) {
-	var hotels Hotels
-	err := r.db.NewSelect().Model(&hotels).Scan(context.Background())
+func (r *HotelRepository) GetByID(ctx context.Context, id uuid.UUID) (*Hotel, error) {
+	var hotel Hotel
+	err := r.db.NewSelect().Model(&hotel).Where("id = ?", id).Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	return hotels, nil
+	return &hotel, nil
 }
 
-func (r *HotelRepository) GetByID(id uuid.UUID) (*Hotel, error) {
+// GetByIDWithDeleted Same as GetByID but also finds soft deleted hotels
+func (r *HotelRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*Hotel, error) {
 	var hotel Hotel
-	err := r.db.NewSelect().Model(&hotel).Where("id = ?", id).Scan(context.Background())
+	err := r.db.NewSelect().Model(&hotel).Where("id = ?", id).WhereAllWithDeleted().Scan(ctx)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
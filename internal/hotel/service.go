@@ -1,82 +1,151 @@
 package hotel
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 
 	"github.com/gofrs/uuid/v5"
-	"go.uber.org/zap"
 )
 
+// entityType entity type of the hotels in the audit log and domain events
+const entityType = "hotel"
+
 type HotelService struct {
-	hotelRepo *HotelRepository
-	log       *zap.SugaredLogger
+	hotelRepo          *HotelRepository
+	membershipResolver core.HotelMembershipResolver
 }
 
-func NewService(
-	hotelRepo *HotelRepository,
-) *HotelService {
+func NewService(hotelRepo *HotelRepository, membershipResolver core.HotelMembershipResolver) *HotelService {
 	return &HotelService{
-		hotelRepo: hotelRepo,
-		log:       logger.GetLogger(),
+		hotelRepo:          hotelRepo,
+		membershipResolver: membershipResolver,
 	}
 }
 
-func (s *HotelService) ListHotels() (Hotels, error) {
-	s.log.Infof("fetching all hotels")
+// ListHotels Returns a page of every hotel for administrators, otherwise
+// only of the hotels the principal is a member of.
+func (s *HotelService) ListHotels(
+	ctx context.Context,
+	principal *auth.Principal,
+	filters HotelFilters,
+	page pagination.Params,
+) (Hotels, string, error) {
+	ctx, span := tracing.Start(ctx, "HotelService.ListHotels")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	if principal == nil {
+		return Hotels{}, "", nil
+	}
+
+	if !principal.IsAdmin() {
+		log.Infof("fetching hotels operated by %s", principal.Subject)
 
-	hotels, err := s.hotelRepo.GetAll()
+		hotelIDs, err := s.membershipResolver.ListMemberHotelIDs(ctx, principal.Subject)
+		if err != nil {
+			log.Errorw("error getting principal hotels", "principal", principal.Subject, "error", err)
+			return Hotels{}, "", tracing.Fail(span, err)
+		}
+		if len(hotelIDs) == 0 {
+			return Hotels{}, "", nil
+		}
+		filters.IDs = hotelIDs
+	} else {
+		log.Infof("fetching all hotels")
+	}
+
+	hotels, nextCursor, err := s.hotelRepo.GetAll(ctx, filters, page)
 	if err != nil {
-		s.log.Errorw("error getting hotels information", "error", err)
-		return Hotels{}, err
+		log.Errorw("error getting hotels information", "error", err)
+		return Hotels{}, "", tracing.Fail(span, err)
 	}
 
-	return hotels, nil
+	return hotels, nextCursor, nil
 }
 
-func (s *HotelService) GetHotelByID(id uuid.UUID) (*Hotel, error) {
-	s.log.Infof("fetching hotel by id: %s", id)
+// GetHotelByID Returns the hotel, soft deleted ones are only found when
+// includeDeleted is set
+func (s *HotelService) GetHotelByID(ctx context.Context, id uuid.UUID, includeDeleted bool) (*Hotel, error) {
+	ctx, span := tracing.Start(ctx, "HotelService.GetHotelByID")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	log.Infof("fetching hotel by id: %s", id)
 
-	hotel, err := s.hotelRepo.GetByID(id)
+	var (
+		hotel *Hotel
+		err   error
+	)
+	if includeDeleted {
+		hotel, err = s.hotelRepo.GetByIDWithDeleted(ctx, id)
+	} else {
+		hotel, err = s.hotelRepo.GetByID(ctx, id)
+	}
 	if err != nil {
-		return nil, errors.New("unexpected error fetching hotel")
+		return nil, tracing.Fail(span, errors.New("unexpected error fetching hotel"))
+	}
+	if hotel == nil {
+		return nil, tracing.Fail(span, ErrHotelNotFound)
 	}
 
 	return hotel, nil
 }
 
-func (s *HotelService) CreateHotel(hotel *Hotel) (*Hotel, error) {
-	s.log.Infof("creating a new hotel: %s", hotel.Name)
+func (s *HotelService) CreateHotel(ctx context.Context, actor core.Actor, hotel *Hotel) (*Hotel, error) {
+	ctx, span := tracing.Start(ctx, "HotelService.CreateHotel")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	log.Infof("creating a new hotel: %s", hotel.Name)
 
-	if err := s.hotelRepo.Save(hotel); err != nil {
-		s.log.Errorf("failed to create hotel: %v", err)
-		return nil, err
+	record := newAuditRecord(actor, core.ActionCreate, hotel.ID, nil, NewHotelResponse(hotel))
+	if err := s.hotelRepo.Save(ctx, hotel, record, newEvent(core.HotelCreated, hotel)); err != nil {
+		log.Errorf("failed to create hotel: %v", err)
+		return nil, tracing.Fail(span, err)
 	}
+	metrics.RecordChange(entityType, core.ActionCreate)
 
-	s.log.Infow("hotel created successfully", "hotel_id", hotel.ID)
+	log.Infow("hotel created successfully", "hotel_id", hotel.ID)
 	return hotel, nil
 }
 
 func (s *HotelService) UpdatePartiallyHotel(
+	ctx context.Context,
+	actor core.Actor,
 	id uuid.UUID,
 	name *string,
 	address *string,
 	status *string,
 	description *string,
 ) (*Hotel, error) {
-	s.log.Infof("updating hotel instance with id: %v", id)
+	ctx, span := tracing.Start(ctx, "HotelService.UpdatePartiallyHotel")
+	defer span.End()
+	log := logger.WithContext(ctx)
 
-	hotel, err := s.hotelRepo.GetByID(id)
+	log.Infof("updating hotel instance with id: %v", id)
+
+	hotel, err := s.hotelRepo.GetByID(ctx, id)
 	if err != nil {
-		return nil, nil
+		return nil, tracing.Fail(span, err)
 	}
 
 	if hotel == nil {
-		s.log.Infof("hotel not found: %v", id)
-		return nil, ErrHotelNotFound
+		log.Infof("hotel not found: %v", id)
+		return nil, tracing.Fail(span, ErrHotelNotFound)
 	}
 
+	before := NewHotelResponse(hotel)
+
 	if name != nil {
 		hotel.Name = *name
 	}
@@ -87,22 +156,163 @@ func (s *HotelService) UpdatePartiallyHotel(
 		hotel.Status = *status
 	}
 	if description != nil {
-		hotel.Status = *description
+		hotel.Description = *description
+	}
+
+	record := newAuditRecord(actor, core.ActionUpdate, hotel.ID, before, NewHotelResponse(hotel))
+	if err := s.hotelRepo.Update(ctx, hotel, record, newEvent(core.HotelUpdated, hotel)); err != nil {
+		log.Errorf("failed updating hotel information", "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionUpdate)
+
+	log.Infow("updated hotel information successfully", "hotel_id", hotel.ID)
+	return hotel, nil
+}
+
+// DeleteHotel Soft deletes the hotel. Hotels with room types or rooms are
+// only deleted along with them when cascade is set, hotels with upcoming
+// reservations, stays in house or open folios are never deleted.
+func (s *HotelService) DeleteHotel(ctx context.Context, actor core.Actor, id uuid.UUID, cascade bool) error {
+	ctx, span := tracing.Start(ctx, "HotelService.DeleteHotel")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	log.Infow("deleting hotel", "hotel_id", id, "cascade", cascade)
+
+	hotel, err := s.hotelRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Errorw("failed retrieving hotel to delete", "hotel_id", id, "error", err)
+		return tracing.Fail(span, err)
+	}
+	if hotel == nil {
+		return tracing.Fail(span, ErrHotelNotFound)
+	}
+
+	dependents, err := s.hotelRepo.CountDependents(ctx, id)
+	if err != nil {
+		log.Errorw("failed counting hotel dependents", "hotel_id", id, "error", err)
+		return tracing.Fail(span, err)
 	}
 
-	if err := s.hotelRepo.Update(hotel); err != nil {
-		s.log.Errorf("failed updating hotel information", "error", err)
-		return nil, err
+	if dependents.Reservations > 0 || dependents.Stays > 0 || dependents.Folios > 0 {
+		return tracing.Fail(span, core.Conflict(
+			"hotel_has_reservations",
+			fmt.Sprintf(
+				"hotel has %d upcoming reservations, %d stays in house and %d open folios and cannot be deleted",
+				dependents.Reservations, dependents.Stays, dependents.Folios,
+			),
+			map[string]string{
+				"reservations": strconv.Itoa(dependents.Reservations),
+				"stays":        strconv.Itoa(dependents.Stays),
+				"folios":       strconv.Itoa(dependents.Folios),
+			},
+		))
 	}
 
-	s.log.Infow("updated hotel information successfully", "hotel_id", hotel.ID)
+	if !cascade && (dependents.RoomTypes > 0 || dependents.Rooms > 0) {
+		return tracing.Fail(span, core.Conflict(
+			"hotel_has_inventory",
+			fmt.Sprintf(
+				"hotel still has %d room types and %d rooms, use cascade=true to delete them too",
+				dependents.RoomTypes, dependents.Rooms,
+			),
+			map[string]string{
+				"room_types": strconv.Itoa(dependents.RoomTypes),
+				"rooms":      strconv.Itoa(dependents.Rooms),
+			},
+		))
+	}
+
+	record := newAuditRecord(actor, core.ActionDelete, id, NewHotelResponse(hotel), nil)
+	if err := s.hotelRepo.Delete(ctx, id, cascade, record, newEvent(core.HotelDeleted, hotel)); err != nil {
+		log.Errorw("failed deleting hotel", "hotel_id", id, "error", err)
+		return tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionDelete)
+
+	log.Infow("hotel deleted successfully", "hotel_id", id)
+	return nil
+}
+
+// RestoreHotel Undoes the soft deletion of the hotel together with the room
+// types and rooms that were deleted with it
+func (s *HotelService) RestoreHotel(ctx context.Context, actor core.Actor, id uuid.UUID) (*Hotel, error) {
+	ctx, span := tracing.Start(ctx, "HotelService.RestoreHotel")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	log.Infow("restoring hotel", "hotel_id", id)
+
+	hotel, err := s.hotelRepo.GetByIDWithDeleted(ctx, id)
+	if err != nil {
+		log.Errorw("failed retrieving hotel to restore", "hotel_id", id, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	if hotel == nil {
+		return nil, tracing.Fail(span, ErrHotelNotFound)
+	}
+	if hotel.DeletedAt.IsZero() {
+		return nil, tracing.Fail(span, ErrHotelNotDeleted)
+	}
+
+	restored := *hotel
+	restored.DeletedAt = time.Time{}
+
+	record := newAuditRecord(actor, core.ActionRestore, id, NewHotelResponse(hotel), NewHotelResponse(&restored))
+	if err := s.hotelRepo.Restore(ctx, hotel, record, newEvent(core.HotelRestored, &restored)); err != nil {
+		log.Errorw("failed restoring hotel", "hotel_id", id, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionRestore)
+	hotel = &restored
+
+	log.Infow("hotel restored successfully", "hotel_id", id)
 	return hotel, nil
 }
 
-func (s *HotelService) ValidateHotelExists(id uuid.UUID) (bool, error) {
-	hotel, err := s.hotelRepo.GetByID(id)
+func (s *HotelService) ValidateHotelExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	ctx, span := tracing.Start(ctx, "HotelService.ValidateHotelExists")
+	defer span.End()
+
+	hotel, err := s.hotelRepo.GetByID(ctx, id)
 	if err != nil {
-		return false, err
+		return false, tracing.Fail(span, err)
 	}
 	return hotel != nil, nil
 }
+
+// ResolveHotelCurrency Returns the currency of the hotel's prices
+func (s *HotelService) ResolveHotelCurrency(ctx context.Context, id uuid.UUID) (string, error) {
+	hotel, err := s.GetHotelByID(ctx, id, false)
+	if err != nil {
+		return "", err
+	}
+	return hotel.Currency, nil
+}
+
+// newAuditRecord Audit record of a change made to the hotel by the actor
+func newAuditRecord(actor core.Actor, action string, id uuid.UUID, before, after any) core.AuditRecord {
+	return core.AuditRecord{
+		Actor: actor,
+		Change: core.Change{
+			HotelID:    id,
+			EntityType: entityType,
+			EntityID:   id,
+			Action:     action,
+			Before:     before,
+			After:      after,
+		},
+	}
+}
+
+// newEvent Domain event of a change made to the hotel
+func newEvent(eventType string, hotel *Hotel) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          eventType,
+		HotelID:       hotel.ID,
+		AggregateType: entityType,
+		AggregateID:   hotel.ID,
+		Payload:       NewHotelResponse(hotel),
+	}
+}
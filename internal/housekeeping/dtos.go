@@ -0,0 +1,142 @@
+package housekeeping
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SetFrequencyRequest struct {
+	StayoverEveryDays *int `json:"stayover_every_days" validate:"required,min=0,max=30"`
+}
+
+type GenerateTasksRequest struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02"`
+}
+
+type AssignTaskRequest struct {
+	Assignee string `json:"assignee" validate:"required,max=256"`
+}
+
+type FrequencyResponse struct {
+	RoomTypeID        uuid.UUID `json:"room_type_id"`
+	StayoverEveryDays int       `json:"stayover_every_days"`
+	UpdatedAt         string    `json:"updated_at"`
+}
+
+type ListFrequenciesResponse struct {
+	// DefaultStayoverEveryDays applies to the room types without a frequency
+	DefaultStayoverEveryDays int                 `json:"default_stayover_every_days"`
+	Results                  []FrequencyResponse `json:"results"`
+}
+
+type TaskResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   string    `json:"created_at"`
+	UpdatedAt   string    `json:"updated_at"`
+	HotelID     uuid.UUID `json:"hotel_id"`
+	RoomID      uuid.UUID `json:"room_id"`
+	Floor       int       `json:"floor"`
+	RoomNumber  int       `json:"room_number"`
+	Date        string    `json:"date"`
+	Kind        string    `json:"kind"`
+	Status      string    `json:"status"`
+	Assignee    string    `json:"assignee,omitempty"`
+	StartedAt   string    `json:"started_at,omitempty"`
+	CompletedAt string    `json:"completed_at,omitempty"`
+}
+
+type ListTasksResponse struct {
+	Results []TaskResponse `json:"results"`
+}
+
+type FloorResponse struct {
+	Floor     int            `json:"floor"`
+	Pending   int            `json:"pending"`
+	Started   int            `json:"in_progress"`
+	Completed int            `json:"completed"`
+	Tasks     []TaskResponse `json:"tasks"`
+}
+
+type BoardResponse struct {
+	HotelID uuid.UUID       `json:"hotel_id"`
+	Date    string          `json:"date"`
+	Floors  []FloorResponse `json:"floors"`
+}
+
+func NewFrequencyResponse(f *Frequency) FrequencyResponse {
+	return FrequencyResponse{
+		RoomTypeID:        f.RoomTypeID,
+		StayoverEveryDays: f.StayoverEveryDays,
+		UpdatedAt:         f.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListFrequenciesResponse(frequencies Frequencies) ListFrequenciesResponse {
+	responses := make([]FrequencyResponse, len(frequencies))
+	for i, frequency := range frequencies {
+		responses[i] = NewFrequencyResponse(&frequency)
+	}
+	return ListFrequenciesResponse{
+		DefaultStayoverEveryDays: DefaultStayoverEveryDays,
+		Results:                  responses,
+	}
+}
+
+func NewTaskResponse(t *Task) TaskResponse {
+	resp := TaskResponse{
+		ID:         t.ID,
+		CreatedAt:  t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  t.UpdatedAt.Format(time.RFC3339),
+		HotelID:    t.HotelID,
+		RoomID:     t.RoomID,
+		Floor:      t.Floor,
+		RoomNumber: t.RoomNumber,
+		Date:       t.Date.Format(time.DateOnly),
+		Kind:       t.Kind,
+		Status:     t.Status,
+		Assignee:   t.Assignee,
+	}
+	if !t.StartedAt.IsZero() {
+		resp.StartedAt = t.StartedAt.Format(time.RFC3339)
+	}
+	if !t.CompletedAt.IsZero() {
+		resp.CompletedAt = t.CompletedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func NewListTasksResponse(tasks Tasks) ListTasksResponse {
+	responses := make([]TaskResponse, len(tasks))
+	for i, task := range tasks {
+		responses[i] = NewTaskResponse(&task)
+	}
+	return ListTasksResponse{Results: responses}
+}
+
+func NewBoardResponse(hotelID uuid.UUID, date time.Time, floors []Floor) BoardResponse {
+	resp := BoardResponse{
+		HotelID: hotelID,
+		Date:    date.Format(time.DateOnly),
+		Floors:  make([]FloorResponse, len(floors)),
+	}
+	for i, floor := range floors {
+		floorResp := FloorResponse{
+			Floor: floor.Floor,
+			Tasks: make([]TaskResponse, len(floor.Tasks)),
+		}
+		for j, task := range floor.Tasks {
+			floorResp.Tasks[j] = NewTaskResponse(&task)
+			switch Status(task.Status) {
+			case PENDING:
+				floorResp.Pending++
+			case IN_PROGRESS:
+				floorResp.Started++
+			case COMPLETED:
+				floorResp.Completed++
+			}
+		}
+		resp.Floors[i] = floorResp
+	}
+	return resp
+}
@@ -0,0 +1,20 @@
+package housekeeping
+
+import (
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound       = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrTaskNotFound        = terrors.NotFound("housekeeping_task", "housekeeping task not found", nil)
+	ErrInvalidDate         = terrors.BadRequest("date", "date must be a date such as 2006-01-02", nil)
+	ErrAssigneeNotMember   = terrors.BadRequest("assignee", "assignee is not a member of the hotel", nil)
+	ErrTaskAssignedToOther = terrors.Forbidden(
+		"housekeeping_task", "task is assigned to another member, ask a supervisor to reassign it", nil,
+	)
+	ErrTaskNotPending    = core.Conflict("task_not_pending", "only pending tasks can be started or assigned", nil)
+	ErrTaskNotInProgress = core.Conflict("task_not_in_progress", "only started tasks can be completed", nil)
+	ErrTaskChanged       = core.Conflict("task_changed", "task changed concurrently, retry the operation", nil)
+)
@@ -0,0 +1,273 @@
+package housekeeping
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type HousekeepingController struct {
+	validator           *validator.Validate
+	housekeepingService *HousekeepingService
+	log                 *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	housekeepingService *HousekeepingService,
+	membershipChecker auth.HotelMembershipChecker,
+) *HousekeepingController {
+	c := &HousekeepingController{
+		validator:           validator,
+		housekeepingService: housekeepingService,
+		log:                 logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHousekeepingRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/housekeeping/board", c.handleGetBoard)
+		r.Get("/v1/hotels/{hotel_id}/housekeeping/frequencies", c.handleListFrequencies)
+	})
+
+	// Staff work the tasks assigned to them
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHousekeepingWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/housekeeping/tasks/{task_id}/start", c.handleStartTask)
+		r.Post("/v1/hotels/{hotel_id}/housekeeping/tasks/{task_id}/complete", c.handleCompleteTask)
+	})
+
+	// Supervisors plan the day
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleHousekeepingWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Put("/v1/hotels/{hotel_id}/housekeeping/frequencies/{room_type_id}", c.handleSetFrequency)
+		r.Post("/v1/hotels/{hotel_id}/housekeeping/tasks:generate", c.handleGenerateTasks)
+		r.Post("/v1/hotels/{hotel_id}/housekeeping/tasks/{task_id}/assign", c.handleAssignTask)
+	})
+
+	return c
+}
+
+func (c *HousekeepingController) handleGetBoard(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	// The board defaults to today's tasks
+	date := time.Now().UTC().Truncate(24 * time.Hour)
+	if rawDate := r.URL.Query().Get("date"); rawDate != "" {
+		parsed, err := time.Parse(time.DateOnly, rawDate)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidDate)
+			return
+		}
+		date = parsed
+	}
+
+	floors, err := c.housekeepingService.Board(r.Context(), hotelID, date)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewBoardResponse(hotelID, date, floors)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleListFrequencies(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	frequencies, err := c.housekeepingService.ListFrequencies(r.Context(), hotelID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListFrequenciesResponse(frequencies)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleSetFrequency(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, roomtype.ErrRoomTypeNotFound)
+		return
+	}
+
+	var payload SetFrequencyRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	frequency, err := c.housekeepingService.SetFrequency(
+		r.Context(), hotelID, uuidRoomTypeID, *payload.StayoverEveryDays,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewFrequencyResponse(frequency)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleGenerateTasks(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload GenerateTasksRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	// The date was already validated against the layout
+	date, _ := time.Parse(time.DateOnly, payload.Date)
+
+	tasks, err := c.housekeepingService.GenerateTasks(r.Context(), hotelID, date)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListTasksResponse(tasks)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleAssignTask(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	taskID, ok := c.taskID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload AssignTaskRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	task, err := c.housekeepingService.AssignTask(r.Context(), hotelID, taskID, payload.Assignee)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTaskResponse(task)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleStartTask(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	taskID, ok := c.taskID(w, r)
+	if !ok {
+		return
+	}
+
+	task, err := c.housekeepingService.StartTask(r.Context(), core.ActorFromContext(r.Context()), hotelID, taskID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTaskResponse(task)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) handleCompleteTask(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	taskID, ok := c.taskID(w, r)
+	if !ok {
+		return
+	}
+
+	task, err := c.housekeepingService.CompleteTask(r.Context(), core.ActorFromContext(r.Context()), hotelID, taskID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTaskResponse(task)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *HousekeepingController) hotelID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return uuid.Nil, false
+	}
+	return uuidHotelID, true
+}
+
+func (c *HousekeepingController) taskID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	taskID := chi.URLParam(r, "task_id")
+	uuidTaskID, err := uuid.FromString(taskID)
+	if err != nil {
+		c.log.Errorw("invalid task id", "taskID", taskID, "error", err)
+		rest.RenderError(r.Context(), w, ErrTaskNotFound)
+		return uuid.Nil, false
+	}
+	return uuidTaskID, true
+}
+
+// decode Reads and validates the request body into payload
+func (c *HousekeepingController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package housekeeping
+
+import (
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/room"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type Kind string
+
+const (
+	// DEPARTURE cleaning of a dirty room before it is sold again
+	DEPARTURE Kind = "departure"
+	// STAYOVER cleaning of an occupied room during the stay
+	STAYOVER Kind = "stayover"
+)
+
+type Status string
+
+const (
+	PENDING     Status = "pending"
+	IN_PROGRESS Status = "in_progress"
+	COMPLETED   Status = "completed"
+)
+
+// DefaultStayoverEveryDays occupied rooms of room types without a stored
+// frequency are cleaned every day
+const DefaultStayoverEveryDays = 1
+
+// --------------------
+// DB models
+// --------------------
+
+// Frequency cleaning frequency of the occupied rooms of a room type, rooms
+// are cleaned every StayoverEveryDays days and never when it is 0
+type Frequency struct {
+	bun.BaseModel     `bun:"table:housekeeping_frequencies"`
+	RoomTypeID        uuid.UUID `bun:"room_type_id,pk"`
+	HotelID           uuid.UUID `bun:"hotel_id"`
+	UpdatedAt         time.Time `bun:"updated_at"`
+	StayoverEveryDays int       `bun:"stayover_every_days"`
+}
+
+type Frequencies []Frequency
+
+// Task cleaning of a room on a date. Rooms have at most one task a day.
+type Task struct {
+	bun.BaseModel `bun:"table:housekeeping_tasks,alias:t"`
+	ID            uuid.UUID `bun:"id,pk"`
+	CreatedAt     time.Time `bun:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	RoomID        uuid.UUID `bun:"room_id"`
+	Date          time.Time `bun:"date,type:date"`
+	Kind          string    `bun:"kind"`
+	Status        string    `bun:"status"`
+	Assignee      string    `bun:"assignee"`
+	StartedAt     time.Time `bun:"started_at,nullzero"`
+	CompletedAt   time.Time `bun:"completed_at,nullzero"`
+	// Floor and RoomNumber are read from the task's room
+	Floor      int `bun:"floor,scanonly"`
+	RoomNumber int `bun:"room_number,scanonly"`
+}
+
+type Tasks []Task
+
+// RoomToClean room of a hotel that may need a task on a date
+type RoomToClean struct {
+	RoomID      uuid.UUID `bun:"room_id"`
+	RoomTypeID  uuid.UUID `bun:"room_type_id"`
+	Floor       int       `bun:"floor"`
+	Number      int       `bun:"number"`
+	Status      string    `bun:"status"`
+	LastCleaned time.Time `bun:"last_cleaned,nullzero"`
+}
+
+// Floor tasks of the rooms of a floor on the board
+type Floor struct {
+	Floor int
+	Tasks Tasks
+}
+
+func NewFrequency(hotelID uuid.UUID, roomTypeID uuid.UUID, stayoverEveryDays int) *Frequency {
+	return &Frequency{
+		RoomTypeID:        roomTypeID,
+		HotelID:           hotelID,
+		UpdatedAt:         time.Now().UTC(),
+		StayoverEveryDays: stayoverEveryDays,
+	}
+}
+
+func NewTask(hotelID uuid.UUID, r RoomToClean, date time.Time, kind Kind) (*Task, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Task{
+		ID:         id,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+		HotelID:    hotelID,
+		RoomID:     r.RoomID,
+		Date:       date,
+		Kind:       string(kind),
+		Status:     string(PENDING),
+		Floor:      r.Floor,
+		RoomNumber: r.Number,
+	}, nil
+}
+
+// TaskKind Returns the kind of task the room needs on date, dirty rooms are
+// cleaned for the next arrival and occupied ones once their stayover
+// cleaning is due. Rooms needing none return false.
+func TaskKind(r RoomToClean, date time.Time, stayoverEveryDays int) (Kind, bool) {
+	switch room.Status(r.Status) {
+	case room.DIRTY:
+		return DEPARTURE, true
+	case room.OCCUPIED:
+		if stayoverEveryDays <= 0 {
+			return "", false
+		}
+		if r.LastCleaned.IsZero() || !date.Before(r.LastCleaned.AddDate(0, 0, stayoverEveryDays)) {
+			return STAYOVER, true
+		}
+	}
+	return "", false
+}
+
+// GroupByFloor Groups the tasks by the floor of their rooms, tasks must be
+// sorted by floor
+func GroupByFloor(tasks Tasks) []Floor {
+	var floors []Floor
+	for _, task := range tasks {
+		if len(floors) == 0 || floors[len(floors)-1].Floor != task.Floor {
+			floors = append(floors, Floor{Floor: task.Floor})
+		}
+		floors[len(floors)-1].Tasks = append(floors[len(floors)-1].Tasks, task)
+	}
+	return floors
+}
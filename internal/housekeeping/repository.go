@@ -0,0 +1,152 @@
+package housekeeping
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/room"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type HousekeepingRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *HousekeepingRepository {
+	return &HousekeepingRepository{
+		db: db,
+	}
+}
+
+// SaveFrequency Stores the cleaning frequency of the room type, replacing
+// the previous one
+func (r *HousekeepingRepository) SaveFrequency(ctx context.Context, frequency *Frequency) error {
+	_, err := r.db.NewInsert().
+		Model(frequency).
+		On("CONFLICT (room_type_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("stayover_every_days = EXCLUDED.stayover_every_days").
+		Exec(ctx)
+	return err
+}
+
+func (r *HousekeepingRepository) GetFrequencies(ctx context.Context, hotelID uuid.UUID) (Frequencies, error) {
+	var frequencies Frequencies
+	err := r.db.NewSelect().
+		Model(&frequencies).
+		Where("hotel_id = ?", hotelID).
+		Order("room_type_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return frequencies, nil
+}
+
+// GetRoomsToClean Returns the hotel's dirty and occupied rooms along with
+// the date of their last task before date
+func (r *HousekeepingRepository) GetRoomsToClean(
+	ctx context.Context, hotelID uuid.UUID, date time.Time,
+) ([]RoomToClean, error) {
+	var rooms []RoomToClean
+	err := r.db.NewSelect().
+		TableExpr("rooms AS r").
+		ColumnExpr("r.id AS room_id").
+		ColumnExpr("r.room_type_id").
+		ColumnExpr("r.floor").
+		ColumnExpr("r.number").
+		ColumnExpr("r.status").
+		ColumnExpr("(SELECT MAX(t.date) FROM housekeeping_tasks AS t WHERE t.room_id = r.id AND t.date < ?) AS last_cleaned", date).
+		Where("r.hotel_id = ?", hotelID).
+		Where("r.deleted_at IS NULL").
+		Where("r.status IN (?)", bun.In([]string{"dirty", "occupied"})).
+		Scan(ctx, &rooms)
+	if err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// SaveTasks Stores the tasks of rooms that do not have one on the date yet
+func (r *HousekeepingRepository) SaveTasks(ctx context.Context, tasks Tasks) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	_, err := r.db.NewInsert().
+		Model(&tasks).
+		On("CONFLICT (room_id, date) DO NOTHING").
+		Returning("NULL").
+		Exec(ctx)
+	return err
+}
+
+func (r *HousekeepingRepository) GetTaskByID(ctx context.Context, id uuid.UUID) (*Task, error) {
+	var task Task
+	err := r.selectTasks(&task).
+		Where("t.id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// GetTasksByDate Returns the hotel's tasks of the date sorted by floor and
+// room number
+func (r *HousekeepingRepository) GetTasksByDate(ctx context.Context, hotelID uuid.UUID, date time.Time) (Tasks, error) {
+	var tasks Tasks
+	err := r.selectTasks(&tasks).
+		Where("t.hotel_id = ?", hotelID).
+		Where("t.date = ?", date).
+		Order("r.floor ASC", "r.number ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// UpdateTask Stores the task unless its status is no longer fromStatus, and
+// the room status change of the task when there is one, within the same
+// transaction
+func (r *HousekeepingRepository) UpdateTask(
+	ctx context.Context, task *Task, fromStatus string, change *room.StatusChange,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(task).
+			Column("updated_at", "status", "assignee", "started_at", "completed_at").
+			WherePK().
+			Where("status = ?", fromStatus).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrTaskChanged
+		}
+
+		if change == nil {
+			return nil
+		}
+		return room.ApplyStatusChange(ctx, tx, change)
+	})
+}
+
+// selectTasks Selects the tasks along with the floor and number of their rooms
+func (r *HousekeepingRepository) selectTasks(model any) *bun.SelectQuery {
+	return r.db.NewSelect().
+		Model(model).
+		ColumnExpr("t.*").
+		ColumnExpr("r.floor").
+		ColumnExpr("r.number AS room_number").
+		Join("JOIN rooms AS r ON r.id = t.room_id")
+}
@@ -0,0 +1,312 @@
+package housekeeping
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type HousekeepingService struct {
+	housekeepingRepo  *HousekeepingRepository
+	hotelValidator    core.HotelValidator
+	roomTypeService   *roomtype.RoomTypeService
+	roomService       *room.RoomService
+	membershipChecker auth.HotelMembershipChecker
+	log               *zap.SugaredLogger
+}
+
+func NewService(
+	housekeepingRepo *HousekeepingRepository,
+	hotelValidator core.HotelValidator,
+	roomTypeService *roomtype.RoomTypeService,
+	roomService *room.RoomService,
+	membershipChecker auth.HotelMembershipChecker,
+) *HousekeepingService {
+	return &HousekeepingService{
+		housekeepingRepo:  housekeepingRepo,
+		hotelValidator:    hotelValidator,
+		roomTypeService:   roomTypeService,
+		roomService:       roomService,
+		membershipChecker: membershipChecker,
+		log:               logger.GetLogger(),
+	}
+}
+
+func (s *HousekeepingService) ListFrequencies(ctx context.Context, hotelID uuid.UUID) (Frequencies, error) {
+	if err := s.validateHotel(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	frequencies, err := s.housekeepingRepo.GetFrequencies(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving cleaning frequencies", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return frequencies, nil
+}
+
+// SetFrequency Sets how often the occupied rooms of the hotel's room type
+// are cleaned
+func (s *HousekeepingService) SetFrequency(
+	ctx context.Context, hotelID uuid.UUID, roomTypeID uuid.UUID, stayoverEveryDays int,
+) (*Frequency, error) {
+	if _, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false); err != nil {
+		return nil, err
+	}
+
+	frequency := NewFrequency(hotelID, roomTypeID, stayoverEveryDays)
+	if err := s.housekeepingRepo.SaveFrequency(ctx, frequency); err != nil {
+		s.log.Errorw("error saving cleaning frequency", "roomTypeID", roomTypeID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("cleaning frequency set successfully", "roomTypeID", roomTypeID, "every", stayoverEveryDays)
+
+	return frequency, nil
+}
+
+// GenerateTasks Creates the tasks of the hotel's rooms that need cleaning on
+// date and returns every task of the date. Rooms that already have a task
+// on the date keep it, so generating again only adds the missing ones.
+func (s *HousekeepingService) GenerateTasks(ctx context.Context, hotelID uuid.UUID, date time.Time) (Tasks, error) {
+	if err := s.validateHotel(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	frequencies, err := s.housekeepingRepo.GetFrequencies(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving cleaning frequencies", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	everyDays := make(map[uuid.UUID]int, len(frequencies))
+	for _, frequency := range frequencies {
+		everyDays[frequency.RoomTypeID] = frequency.StayoverEveryDays
+	}
+
+	rooms, err := s.housekeepingRepo.GetRoomsToClean(ctx, hotelID, date)
+	if err != nil {
+		s.log.Errorw("error retrieving rooms to clean", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+
+	var tasks Tasks
+	for _, r := range rooms {
+		stayoverEveryDays, ok := everyDays[r.RoomTypeID]
+		if !ok {
+			stayoverEveryDays = DefaultStayoverEveryDays
+		}
+		kind, ok := TaskKind(r, date, stayoverEveryDays)
+		if !ok {
+			continue
+		}
+		task, err := NewTask(hotelID, r, date, kind)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, *task)
+	}
+
+	if err := s.housekeepingRepo.SaveTasks(ctx, tasks); err != nil {
+		s.log.Errorw("error saving housekeeping tasks", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("housekeeping tasks generated", "hotelID", hotelID, "date", date.Format(time.DateOnly))
+
+	return s.ListTasks(ctx, hotelID, date)
+}
+
+// ListTasks Returns the hotel's tasks of the date sorted by floor and room
+// number
+func (s *HousekeepingService) ListTasks(ctx context.Context, hotelID uuid.UUID, date time.Time) (Tasks, error) {
+	tasks, err := s.housekeepingRepo.GetTasksByDate(ctx, hotelID, date)
+	if err != nil {
+		s.log.Errorw("error retrieving housekeeping tasks", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// Board Returns the hotel's tasks of the date grouped by floor
+func (s *HousekeepingService) Board(ctx context.Context, hotelID uuid.UUID, date time.Time) ([]Floor, error) {
+	if err := s.validateHotel(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.ListTasks(ctx, hotelID, date)
+	if err != nil {
+		return nil, err
+	}
+	return GroupByFloor(tasks), nil
+}
+
+func (s *HousekeepingService) RetrieveTask(ctx context.Context, hotelID uuid.UUID, taskID uuid.UUID) (*Task, error) {
+	task, err := s.housekeepingRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		s.log.Errorw("error retrieving housekeeping task", "taskID", taskID, "error", err)
+		return nil, err
+	}
+	if task == nil || task.HotelID != hotelID {
+		return nil, ErrTaskNotFound
+	}
+	return task, nil
+}
+
+// AssignTask Assigns a pending task to a member of the hotel
+func (s *HousekeepingService) AssignTask(
+	ctx context.Context, hotelID uuid.UUID, taskID uuid.UUID, assignee string,
+) (*Task, error) {
+	task, err := s.RetrieveTask(ctx, hotelID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status != string(PENDING) {
+		return nil, ErrTaskNotPending
+	}
+
+	role, err := s.membershipChecker.GetHotelRole(ctx, assignee, hotelID)
+	if err != nil {
+		s.log.Errorw("error resolving hotel membership", "principal", assignee, "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	if role == "" {
+		return nil, ErrAssigneeNotMember
+	}
+
+	task.Assignee = assignee
+	task.UpdatedAt = time.Now().UTC()
+	if err := s.housekeepingRepo.UpdateTask(ctx, task, string(PENDING), nil); err != nil {
+		s.log.Errorw("failure assigning housekeeping task", "taskID", taskID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("housekeeping task assigned successfully", "taskID", taskID, "assignee", assignee)
+
+	return task, nil
+}
+
+// StartTask Starts a pending task of the actor, unassigned tasks are
+// assigned to the actor. Departure cleanings move their room into cleaning
+// in the same transaction.
+func (s *HousekeepingService) StartTask(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, taskID uuid.UUID,
+) (*Task, error) {
+	task, err := s.retrieveActorTask(ctx, actor, hotelID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status != string(PENDING) {
+		return nil, ErrTaskNotPending
+	}
+
+	change, err := s.newRoomStatusChange(ctx, actor, task, room.CLEANING, "housekeeping task started")
+	if err != nil {
+		return nil, err
+	}
+
+	task.Assignee = actor.Principal
+	task.Status = string(IN_PROGRESS)
+	task.UpdatedAt = time.Now().UTC()
+	task.StartedAt = task.UpdatedAt
+	if err := s.housekeepingRepo.UpdateTask(ctx, task, string(PENDING), change); err != nil {
+		s.log.Errorw("failure starting housekeeping task", "taskID", taskID, "error", err)
+		return nil, err
+	}
+	if change != nil {
+		s.roomService.StatusChanged(ctx, change)
+	}
+
+	s.log.Infow("housekeeping task started successfully", "taskID", taskID, "assignee", task.Assignee)
+
+	return task, nil
+}
+
+// CompleteTask Completes a started task of the actor. Departure cleanings
+// leave their room inspected, ready to be released by a supervisor, in the
+// same transaction.
+func (s *HousekeepingService) CompleteTask(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, taskID uuid.UUID,
+) (*Task, error) {
+	task, err := s.retrieveActorTask(ctx, actor, hotelID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status != string(IN_PROGRESS) {
+		return nil, ErrTaskNotInProgress
+	}
+
+	change, err := s.newRoomStatusChange(ctx, actor, task, room.INSPECTED, "housekeeping task completed")
+	if err != nil {
+		return nil, err
+	}
+
+	task.Status = string(COMPLETED)
+	task.UpdatedAt = time.Now().UTC()
+	task.CompletedAt = task.UpdatedAt
+	if err := s.housekeepingRepo.UpdateTask(ctx, task, string(IN_PROGRESS), change); err != nil {
+		s.log.Errorw("failure completing housekeeping task", "taskID", taskID, "error", err)
+		return nil, err
+	}
+	if change != nil {
+		s.roomService.StatusChanged(ctx, change)
+	}
+
+	s.log.Infow("housekeeping task completed successfully", "taskID", taskID, "assignee", task.Assignee)
+
+	return task, nil
+}
+
+// retrieveActorTask Returns the hotel's task when it is unassigned or
+// assigned to the actor
+func (s *HousekeepingService) retrieveActorTask(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, taskID uuid.UUID,
+) (*Task, error) {
+	task, err := s.RetrieveTask(ctx, hotelID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Assignee != "" && task.Assignee != actor.Principal {
+		return nil, ErrTaskAssignedToOther
+	}
+	return task, nil
+}
+
+// newRoomStatusChange Returns the change moving the room of a departure
+// cleaning into status, nil for other tasks or when the room already is in it
+func (s *HousekeepingService) newRoomStatusChange(
+	ctx context.Context, actor core.Actor, task *Task, status room.Status, reason string,
+) (*room.StatusChange, error) {
+	if task.Kind != string(DEPARTURE) {
+		return nil, nil
+	}
+
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, task.HotelID, task.RoomID, false)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == string(status) {
+		return nil, nil
+	}
+
+	return s.roomService.NewStatusChange(ctx, actor, task.HotelID, task.RoomID, string(status), reason)
+}
+
+func (s *HousekeepingService) validateHotel(ctx context.Context, hotelID uuid.UUID) error {
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return err
+	}
+	if !hotelExist {
+		return ErrHotelNotFound
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type UpdateInventoryRequest struct {
+	StartDate         string   `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate           string   `json:"end_date" validate:"required,datetime=2006-01-02"`
+	DaysOfWeek        []string `json:"days_of_week" validate:"max=7"`
+	TotalRooms        *int     `json:"total_rooms" validate:"omitempty,min=0"`
+	ResetTotalRooms   bool     `json:"reset_total_rooms" validate:"excluded_with=TotalRooms"`
+	Blocked           *int     `json:"blocked" validate:"omitempty,min=0"`
+	StopSell          *bool    `json:"stop_sell"`
+	MinStay           *int     `json:"min_stay" validate:"omitempty,min=0"`
+	MaxStay           *int     `json:"max_stay" validate:"omitempty,min=0"`
+	ClosedToArrival   *bool    `json:"closed_to_arrival"`
+	ClosedToDeparture *bool    `json:"closed_to_departure"`
+}
+
+type DayResponse struct {
+	Date              string `json:"date"`
+	TotalRooms        int    `json:"total_rooms"`
+	TotalRoomsFixed   bool   `json:"total_rooms_fixed"`
+	Sold              int    `json:"sold"`
+	Blocked           int    `json:"blocked"`
+	OutOfOrder        int    `json:"out_of_order"`
+	Available         int    `json:"available"`
+	StopSell          bool   `json:"stop_sell"`
+	MinStay           int    `json:"min_stay"`
+	MaxStay           int    `json:"max_stay"`
+	ClosedToArrival   bool   `json:"closed_to_arrival"`
+	ClosedToDeparture bool   `json:"closed_to_departure"`
+}
+
+type RoomTypeCalendarResponse struct {
+	RoomTypeID uuid.UUID     `json:"room_type_id"`
+	Name       string        `json:"name"`
+	Days       []DayResponse `json:"days"`
+}
+
+type CalendarResponse struct {
+	HotelID   uuid.UUID                  `json:"hotel_id"`
+	From      string                     `json:"from"`
+	To        string                     `json:"to"`
+	RoomTypes []RoomTypeCalendarResponse `json:"room_types"`
+}
+
+func NewDayResponse(d *Day) DayResponse {
+	return DayResponse{
+		Date:              d.Date.Format(time.DateOnly),
+		TotalRooms:        d.TotalRooms,
+		TotalRoomsFixed:   d.TotalRoomsOverride != nil,
+		Sold:              d.Sold,
+		Blocked:           d.Blocked,
+		OutOfOrder:        d.OutOfOrder,
+		Available:         d.Available(),
+		StopSell:          d.StopSell,
+		MinStay:           d.MinStay,
+		MaxStay:           d.MaxStay,
+		ClosedToArrival:   d.ClosedToArrival,
+		ClosedToDeparture: d.ClosedToDeparture,
+	}
+}
+
+func NewCalendarResponse(
+	hotelID uuid.UUID, from, to time.Time, calendars []RoomTypeCalendar,
+) CalendarResponse {
+	roomTypes := make([]RoomTypeCalendarResponse, len(calendars))
+	for i, calendar := range calendars {
+		days := make([]DayResponse, len(calendar.Days))
+		for j, day := range calendar.Days {
+			days[j] = NewDayResponse(&day)
+		}
+		roomTypes[i] = RoomTypeCalendarResponse{
+			RoomTypeID: calendar.RoomTypeID,
+			Name:       calendar.Name,
+			Days:       days,
+		}
+	}
+
+	return CalendarResponse{
+		HotelID:   hotelID,
+		From:      from.Format(time.DateOnly),
+		To:        to.Format(time.DateOnly),
+		RoomTypes: roomTypes,
+	}
+}
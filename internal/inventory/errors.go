@@ -0,0 +1,59 @@
+package inventory
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound        = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrInvalidCalendarRange = terrors.BadRequest(
+		"calendar_dates",
+		fmt.Sprintf("from and to must be dates with to after from and at most %d nights apart", MaxCalendarNights),
+		nil,
+	)
+	ErrInvalidUpdateRange = terrors.BadRequest(
+		"update_dates",
+		fmt.Sprintf("end_date must not be before start_date and at most %d nights after it", MaxCalendarNights-1),
+		nil,
+	)
+	ErrInvalidStayLimits     = terrors.BadRequest("stay_limits", "max_stay must not be lower than min_stay", nil)
+	ErrInvalidDayOfWeek      = terrors.BadRequest("days_of_week", "days_of_week must be day names such as monday", nil)
+	ErrInvalidRoomTypeFilter = terrors.BadRequest("room_type_id", "room_type_id must be a valid identifier", nil)
+	ErrClosedToArrival       = terrors.PreconditionFailed(
+		"closed_to_arrival", "room type is closed to arrival on the check-in date", nil,
+	)
+	ErrClosedToDeparture = terrors.PreconditionFailed(
+		"closed_to_departure", "room type is closed to departure on the check-out date", nil,
+	)
+	ErrStopSell = terrors.PreconditionFailed(
+		"stop_sell", "room type is not on sale for some of the requested nights", nil,
+	)
+	ErrSoldOut = core.Conflict(
+		"sold_out", "room type has no rooms left to sell for some of the requested nights", nil,
+	)
+)
+
+// newStayTooShortError Error of a stay shorter than the minimum stay of its
+// arrival day
+func newStayTooShortError(minStay int) error {
+	return terrors.PreconditionFailed(
+		"min_stay",
+		fmt.Sprintf("stays arriving on the check-in date last at least %d nights", minStay),
+		map[string]string{"min_stay": strconv.Itoa(minStay)},
+	)
+}
+
+// newStayTooLongError Error of a stay longer than the maximum stay of its
+// arrival day
+func newStayTooLongError(maxStay int) error {
+	return terrors.PreconditionFailed(
+		"max_stay",
+		fmt.Sprintf("stays arriving on the check-in date last at most %d nights", maxStay),
+		map[string]string{"max_stay": strconv.Itoa(maxStay)},
+	)
+}
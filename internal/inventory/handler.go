@@ -0,0 +1,162 @@
+package inventory
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/internal/rateplan"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type InventoryController struct {
+	validator        *validator.Validate
+	inventoryService *InventoryService
+	log              *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	inventoryService *InventoryService,
+	membershipChecker auth.HotelMembershipChecker,
+) *InventoryController {
+	c := &InventoryController{
+		validator:        validator,
+		inventoryService: inventoryService,
+		log:              logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/inventory", c.handleGetHotelInventory)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Patch("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}/inventory", c.handleUpdateRoomTypeInventory)
+	})
+
+	return c
+}
+
+func (c *InventoryController) handleGetHotelInventory(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+
+	from, err := time.Parse(time.DateOnly, query.Get("from"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidCalendarRange)
+		return
+	}
+	to, err := time.Parse(time.DateOnly, query.Get("to"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidCalendarRange)
+		return
+	}
+
+	var roomTypeID *uuid.UUID
+	if rawRoomTypeID := query.Get("room_type_id"); rawRoomTypeID != "" {
+		id, err := uuid.FromString(rawRoomTypeID)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidRoomTypeFilter)
+			return
+		}
+		roomTypeID = &id
+	}
+
+	calendars, err := c.inventoryService.Calendar(r.Context(), uuidHotelID, roomTypeID, from, to)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewCalendarResponse(uuidHotelID, from, to, calendars)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *InventoryController) handleUpdateRoomTypeInventory(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, roomtype.ErrRoomTypeNotFound)
+		return
+	}
+
+	var payload UpdateInventoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	// Dates were already validated against the layout
+	startDate, _ := time.Parse(time.DateOnly, payload.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, payload.EndDate)
+
+	update := Update{
+		TotalRooms:        payload.TotalRooms,
+		ResetTotalRooms:   payload.ResetTotalRooms,
+		Blocked:           payload.Blocked,
+		StopSell:          payload.StopSell,
+		MinStay:           payload.MinStay,
+		MaxStay:           payload.MaxStay,
+		ClosedToArrival:   payload.ClosedToArrival,
+		ClosedToDeparture: payload.ClosedToDeparture,
+	}
+	for _, name := range payload.DaysOfWeek {
+		day, ok := rateplan.ParseWeekday(name)
+		if !ok {
+			rest.RenderError(r.Context(), w, ErrInvalidDayOfWeek)
+			return
+		}
+		update.DaysOfWeek = append(update.DaysOfWeek, day)
+	}
+
+	calendar, err := c.inventoryService.UpdateInventory(
+		r.Context(), uuidHotelID, uuidRoomTypeID, startDate, endDate, update,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewCalendarResponse(uuidHotelID, startDate, endDate.AddDate(0, 0, 1), []RoomTypeCalendar{*calendar})
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
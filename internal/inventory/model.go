@@ -0,0 +1,209 @@
+package inventory
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+// MaxCalendarNights longest range of nights read or updated at once
+const MaxCalendarNights = 366
+
+// --------------------
+// DB models
+// --------------------
+
+// Day sales controls of a room type on a night. Nights without a stored row
+// sell every room of the type without restrictions.
+type Day struct {
+	bun.BaseModel `bun:"table:room_type_inventory"`
+	RoomTypeID    uuid.UUID `bun:"room_type_id,pk"`
+	Date          time.Time `bun:"date,pk,type:date"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	// TotalRoomsOverride rooms sold on the night in place of the rooms of
+	// the type, nights without it follow the rooms added to or removed from
+	// the type
+	TotalRoomsOverride *int `bun:"total_rooms"`
+	Blocked            int  `bun:"blocked"`
+	StopSell           bool `bun:"stop_sell"`
+	MinStay            int  `bun:"min_stay"`
+	MaxStay            int  `bun:"max_stay"`
+	ClosedToArrival    bool `bun:"closed_to_arrival"`
+	ClosedToDeparture  bool `bun:"closed_to_departure"`
+	// TotalRooms is derived from the rooms of the type unless overridden, it
+	// is not stored
+	TotalRooms int `bun:"-"`
+	// Sold is counted from the reservations of the night, it is not stored
+	Sold int `bun:"-"`
+	// OutOfOrder is counted from the room blocks of the night, it is not
+	// stored
+	OutOfOrder int `bun:"-"`
+}
+
+type Days []Day
+
+// RoomTypeRooms rooms a room type of the hotel has
+type RoomTypeRooms struct {
+	RoomTypeID uuid.UUID `bun:"room_type_id"`
+	Name       string    `bun:"name"`
+	Rooms      int       `bun:"rooms"`
+}
+
+// NightSales rooms of a room type reserved on a night
+type NightSales struct {
+	RoomTypeID uuid.UUID `bun:"room_type_id"`
+	Date       time.Time `bun:"date"`
+	Sold       int       `bun:"sold"`
+}
+
+// NightOutOfOrder rooms of a room type out of order on a night
+type NightOutOfOrder struct {
+	RoomTypeID uuid.UUID `bun:"room_type_id"`
+	Date       time.Time `bun:"date"`
+	OutOfOrder int       `bun:"out_of_order"`
+}
+
+// RoomTypeCalendar inventory of a room type for every night of a range. The
+// calendar of a stay holds its nights followed by its departure day.
+type RoomTypeCalendar struct {
+	RoomTypeID uuid.UUID
+	Name       string
+	Days       Days
+}
+
+// Update changes applied to every night of a range, nil fields are left
+// untouched. MinStay and MaxStay of 0 lift the restriction and
+// ResetTotalRooms drops the override of TotalRooms.
+type Update struct {
+	TotalRooms        *int
+	ResetTotalRooms   bool
+	Blocked           *int
+	StopSell          *bool
+	MinStay           *int
+	MaxStay           *int
+	ClosedToArrival   *bool
+	ClosedToDeparture *bool
+	// DaysOfWeek restricts the update to these days of the week when not
+	// empty
+	DaysOfWeek []time.Weekday
+}
+
+// NewDay Returns the inventory of a night without stored controls
+func NewDay(hotelID uuid.UUID, roomTypeID uuid.UUID, date time.Time) Day {
+	return Day{
+		RoomTypeID: roomTypeID,
+		Date:       date,
+		HotelID:    hotelID,
+	}
+}
+
+// SetRooms Derives the total rooms of the night from the rooms of the type
+// unless they are overridden
+func (d *Day) SetRooms(rooms int) {
+	d.TotalRooms = rooms
+	if d.TotalRoomsOverride != nil {
+		d.TotalRooms = *d.TotalRoomsOverride
+	}
+}
+
+// ValidStayLimits Reports whether the maximum stay of the night, when
+// restricted, is not lower than its minimum stay
+func (d *Day) ValidStayLimits() bool {
+	return d.MaxStay == 0 || d.MaxStay >= d.MinStay
+}
+
+// Available Returns the rooms that can still be sold on the night
+func (d *Day) Available() int {
+	if d.StopSell {
+		return 0
+	}
+	return max(d.TotalRooms-d.Sold-d.Blocked-d.OutOfOrder, 0)
+}
+
+// SellableRooms Returns the rooms that can still be sold on every night of
+// the stay
+func (c *RoomTypeCalendar) SellableRooms() int {
+	nights := c.Days[:max(len(c.Days)-1, 0)]
+	if len(nights) == 0 {
+		return 0
+	}
+
+	sellable := nights[0].Available()
+	for _, day := range nights[1:] {
+		sellable = min(sellable, day.Available())
+	}
+	return sellable
+}
+
+// CheckStay Returns the error of the first sales control the stay breaks:
+// its arrival day sets whether it may arrive and its length of stay limits,
+// its departure day whether it may depart, and every night must be on sale
+// with rooms left.
+func (c *RoomTypeCalendar) CheckStay() error {
+	nights := len(c.Days) - 1
+	if nights < 1 {
+		return nil
+	}
+
+	arrival, departure := c.Days[0], c.Days[nights]
+	if arrival.ClosedToArrival {
+		return ErrClosedToArrival
+	}
+	if departure.ClosedToDeparture {
+		return ErrClosedToDeparture
+	}
+	if nights < arrival.MinStay {
+		return newStayTooShortError(arrival.MinStay)
+	}
+	if arrival.MaxStay > 0 && nights > arrival.MaxStay {
+		return newStayTooLongError(arrival.MaxStay)
+	}
+
+	for _, day := range c.Days[:nights] {
+		if day.StopSell {
+			return ErrStopSell
+		}
+	}
+	if c.SellableRooms() < 1 {
+		return ErrSoldOut
+	}
+
+	return nil
+}
+
+// Applies Reports whether the update changes the night
+func (u *Update) Applies(date time.Time) bool {
+	return len(u.DaysOfWeek) == 0 || slices.Contains(u.DaysOfWeek, date.Weekday())
+}
+
+// Apply Sets the fields of the update on the night
+func (u *Update) Apply(day *Day) {
+	if u.TotalRooms != nil {
+		totalRooms := *u.TotalRooms
+		day.TotalRoomsOverride = &totalRooms
+	}
+	if u.ResetTotalRooms {
+		day.TotalRoomsOverride = nil
+	}
+	if u.Blocked != nil {
+		day.Blocked = *u.Blocked
+	}
+	if u.StopSell != nil {
+		day.StopSell = *u.StopSell
+	}
+	if u.MinStay != nil {
+		day.MinStay = *u.MinStay
+	}
+	if u.MaxStay != nil {
+		day.MaxStay = *u.MaxStay
+	}
+	if u.ClosedToArrival != nil {
+		day.ClosedToArrival = *u.ClosedToArrival
+	}
+	if u.ClosedToDeparture != nil {
+		day.ClosedToDeparture = *u.ClosedToDeparture
+	}
+}
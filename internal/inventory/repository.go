@@ -0,0 +1,316 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type InventoryRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *InventoryRepository {
+	return &InventoryRepository{
+		db: db,
+	}
+}
+
+// GetCalendars Returns the inventory of the hotel's room types, or of the
+// given one only, for every night from from until the night before to
+func (r *InventoryRepository) GetCalendars(
+	ctx context.Context, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) ([]RoomTypeCalendar, error) {
+	return getCalendars(ctx, r.db, hotelID, roomTypeID, from, to)
+}
+
+// CheckStay Rejects a stay in a room of the room type from checkIn until
+// checkOut that the sales controls of the inventory do not allow, using db,
+// which is expected to be the transaction storing the reservation. The room
+// type is locked so reservations of the type and updates of its inventory
+// are serialized and cannot oversell it.
+func CheckStay(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID uuid.UUID, checkIn, checkOut time.Time,
+) error {
+	if err := lockRoomType(ctx, db, roomTypeID); err != nil {
+		return err
+	}
+
+	calendars, err := getCalendars(ctx, db, hotelID, &roomTypeID, checkIn, checkOut.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	if len(calendars) == 0 {
+		return nil
+	}
+	return calendars[0].CheckStay()
+}
+
+// getCalendars Merges the stored nights of the hotel's room types with the
+// rooms they have, sold and out of order on every night from from until the
+// night before to
+func getCalendars(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) ([]RoomTypeCalendar, error) {
+	roomTypes, err := getRoomTypeRooms(ctx, db, hotelID, roomTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(roomTypes) == 0 {
+		return nil, nil
+	}
+
+	stored, err := getStoredDays(ctx, db, hotelID, roomTypeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	sales, err := getSales(ctx, db, hotelID, roomTypeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	outOfOrder, err := getOutOfOrder(ctx, db, hotelID, roomTypeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByNight := make(map[nightKey]Day, len(stored))
+	for _, day := range stored {
+		storedByNight[newNightKey(day.RoomTypeID, day.Date)] = day
+	}
+	soldByNight := make(map[nightKey]int, len(sales))
+	for _, sale := range sales {
+		soldByNight[newNightKey(sale.RoomTypeID, sale.Date)] = sale.Sold
+	}
+	outOfOrderByNight := make(map[nightKey]int, len(outOfOrder))
+	for _, night := range outOfOrder {
+		outOfOrderByNight[newNightKey(night.RoomTypeID, night.Date)] = night.OutOfOrder
+	}
+
+	calendars := make([]RoomTypeCalendar, len(roomTypes))
+	for i, rt := range roomTypes {
+		calendar := RoomTypeCalendar{RoomTypeID: rt.RoomTypeID, Name: rt.Name}
+		for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+			key := newNightKey(rt.RoomTypeID, date)
+			day, ok := storedByNight[key]
+			if !ok {
+				day = NewDay(hotelID, rt.RoomTypeID, date)
+			}
+			day.SetRooms(rt.Rooms)
+			day.Sold = soldByNight[key]
+			day.OutOfOrder = outOfOrderByNight[key]
+			calendar.Days = append(calendar.Days, day)
+		}
+		calendars[i] = calendar
+	}
+
+	return calendars, nil
+}
+
+// getRoomTypeRooms Counts the rooms of every room type of the hotel, or of
+// the given one only
+func getRoomTypeRooms(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID *uuid.UUID,
+) ([]RoomTypeRooms, error) {
+	var rooms []RoomTypeRooms
+	q := db.NewSelect().
+		TableExpr("room_types AS rt").
+		ColumnExpr("rt.id AS room_type_id").
+		ColumnExpr("rt.name").
+		ColumnExpr("COUNT(r.id) AS rooms").
+		Join("LEFT JOIN rooms AS r ON r.room_type_id = rt.id AND r.deleted_at IS NULL").
+		Where("rt.hotel_id = ?", hotelID).
+		Where("rt.deleted_at IS NULL").
+		Group("rt.id").
+		Order("rt.name ASC", "rt.id ASC")
+	if roomTypeID != nil {
+		q = q.Where("rt.id = ?", *roomTypeID)
+	}
+
+	if err := q.Scan(ctx, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// getStoredDays Returns the stored nights of the hotel from from until the
+// night before to
+func getStoredDays(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) (Days, error) {
+	var days Days
+	q := db.NewSelect().
+		Model(&days).
+		Where("hotel_id = ?", hotelID).
+		Where("date >= ?", from).
+		Where("date < ?", to)
+	if roomTypeID != nil {
+		q = q.Where("room_type_id = ?", *roomTypeID)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+// salesQuery Selects the room and nights of the hotel's reservations that are
+// not cancelled and of its stays in house checked in without one, which hold
+// their room until their expected check-out, or tonight when they overstay
+const salesQuery = `SELECT room_id, check_in, check_out FROM reservations
+WHERE hotel_id = ? AND status <> 'cancelled'
+UNION ALL
+SELECT room_id, checked_in_at::date, GREATEST(expected_check_out, CURRENT_DATE + 1) FROM stays
+WHERE hotel_id = ? AND status = 'in_house' AND reservation_id IS NULL`
+
+// getSales Counts the rooms of each room type of the hotel reserved, or
+// occupied by a stay without a reservation, on every night from from until
+// the night before to
+func getSales(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) ([]NightSales, error) {
+	var sales []NightSales
+	q := db.NewSelect().
+		TableExpr("("+salesQuery+") AS res", hotelID, hotelID).
+		ColumnExpr("r.room_type_id").
+		ColumnExpr("night::date AS date").
+		ColumnExpr("COUNT(*) AS sold").
+		Join("JOIN rooms AS r ON r.id = res.room_id").
+		Join("JOIN generate_series(?::date, ?::date - 1, '1 day') AS night ON night >= res.check_in AND night < res.check_out", from, to).
+		Group("r.room_type_id", "night")
+	if roomTypeID != nil {
+		q = q.Where("r.room_type_id = ?", *roomTypeID)
+	}
+
+	if err := q.Scan(ctx, &sales); err != nil {
+		return nil, err
+	}
+	return sales, nil
+}
+
+// getOutOfOrder Counts the rooms of each room type of the hotel blocked as
+// out of order on every night from from until the night before to
+func getOutOfOrder(
+	ctx context.Context, db bun.IDB, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) ([]NightOutOfOrder, error) {
+	var outOfOrder []NightOutOfOrder
+	q := db.NewSelect().
+		TableExpr("room_blocks AS b").
+		ColumnExpr("r.room_type_id").
+		ColumnExpr("night::date AS date").
+		ColumnExpr("COUNT(*) AS out_of_order").
+		Join("JOIN rooms AS r ON r.id = b.room_id AND r.deleted_at IS NULL").
+		Join("JOIN generate_series(?::date, ?::date - 1, '1 day') AS night ON night >= b.start_date AND night < b.end_date", from, to).
+		Where("b.hotel_id = ?", hotelID).
+		Group("r.room_type_id", "night")
+	if roomTypeID != nil {
+		q = q.Where("r.room_type_id = ?", *roomTypeID)
+	}
+
+	if err := q.Scan(ctx, &outOfOrder); err != nil {
+		return nil, err
+	}
+	return outOfOrder, nil
+}
+
+// lockRoomType Serializes the reservations of the room type and the updates
+// of its inventory until the transaction of db ends
+func lockRoomType(ctx context.Context, db bun.IDB, roomTypeID uuid.UUID) error {
+	_, err := db.NewSelect().
+		TableExpr("room_types").
+		ColumnExpr("id").
+		Where("id = ?", roomTypeID).
+		For("UPDATE").
+		Exec(ctx)
+	return err
+}
+
+// Update Applies the update to the nights of the room type between
+// startDate and endDate, both included, and stores the events in the outbox
+// within the same transaction. Only the controls are stored, the total rooms
+// of nights without an override keep following the rooms of the type.
+func (r *InventoryRepository) Update(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	roomTypeID uuid.UUID,
+	startDate time.Time,
+	endDate time.Time,
+	update Update,
+	events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Concurrent updates must not overwrite each other's fields
+		if err := lockRoomType(ctx, tx, roomTypeID); err != nil {
+			return err
+		}
+
+		var stored Days
+		err := tx.NewSelect().
+			Model(&stored).
+			Where("room_type_id = ?", roomTypeID).
+			Where("date BETWEEN ? AND ?", startDate, endDate).
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		storedByDate := make(map[string]Day, len(stored))
+		for _, day := range stored {
+			storedByDate[day.Date.Format(time.DateOnly)] = day
+		}
+
+		now := time.Now().UTC()
+		var days Days
+		for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+			if !update.Applies(date) {
+				continue
+			}
+			day, ok := storedByDate[date.Format(time.DateOnly)]
+			if !ok {
+				day = NewDay(hotelID, roomTypeID, date)
+			}
+			update.Apply(&day)
+			// Fields left untouched keep their stored value, the limits
+			// are checked once merged
+			if !day.ValidStayLimits() {
+				return ErrInvalidStayLimits
+			}
+			day.UpdatedAt = now
+			days = append(days, day)
+		}
+		if len(days) == 0 {
+			return nil
+		}
+
+		_, err = tx.NewInsert().
+			Model(&days).
+			On("CONFLICT (room_type_id, date) DO UPDATE").
+			Set("updated_at = EXCLUDED.updated_at").
+			Set("total_rooms = EXCLUDED.total_rooms").
+			Set("blocked = EXCLUDED.blocked").
+			Set("stop_sell = EXCLUDED.stop_sell").
+			Set("min_stay = EXCLUDED.min_stay").
+			Set("max_stay = EXCLUDED.max_stay").
+			Set("closed_to_arrival = EXCLUDED.closed_to_arrival").
+			Set("closed_to_departure = EXCLUDED.closed_to_departure").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// nightKey identifies a night of a room type
+type nightKey struct {
+	roomTypeID uuid.UUID
+	date       string
+}
+
+func newNightKey(roomTypeID uuid.UUID, date time.Time) nightKey {
+	return nightKey{roomTypeID: roomTypeID, date: date.Format(time.DateOnly)}
+}
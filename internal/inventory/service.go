@@ -0,0 +1,130 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+// aggregateType aggregate type of the inventory domain events
+const aggregateType = "room_type_inventory"
+
+// InventoryUpdatedPayload payload of the InventoryUpdated domain event, the
+// nights of the range are to be read again from the calendar
+type InventoryUpdatedPayload struct {
+	RoomTypeID uuid.UUID `json:"room_type_id"`
+	StartDate  string    `json:"start_date"`
+	EndDate    string    `json:"end_date"`
+}
+
+type InventoryService struct {
+	inventoryRepo   *InventoryRepository
+	hotelValidator  core.HotelValidator
+	roomTypeService *roomtype.RoomTypeService
+	log             *zap.SugaredLogger
+}
+
+func NewService(
+	inventoryRepo *InventoryRepository,
+	hotelValidator core.HotelValidator,
+	roomTypeService *roomtype.RoomTypeService,
+) *InventoryService {
+	return &InventoryService{
+		inventoryRepo:   inventoryRepo,
+		hotelValidator:  hotelValidator,
+		roomTypeService: roomTypeService,
+		log:             logger.GetLogger(),
+	}
+}
+
+// Calendar Returns the inventory of the hotel's room types, or of the given
+// one only, for every night from from until the night before to
+func (s *InventoryService) Calendar(
+	ctx context.Context, hotelID uuid.UUID, roomTypeID *uuid.UUID, from, to time.Time,
+) ([]RoomTypeCalendar, error) {
+	if !to.After(from) || to.After(from.AddDate(0, 0, MaxCalendarNights)) {
+		return nil, ErrInvalidCalendarRange
+	}
+
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	if !hotelExist {
+		return nil, ErrHotelNotFound
+	}
+
+	calendars, err := s.inventoryRepo.GetCalendars(ctx, hotelID, roomTypeID, from, to)
+	if err != nil {
+		s.log.Errorw("error retrieving hotel inventory", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	if roomTypeID != nil && len(calendars) == 0 {
+		return nil, roomtype.ErrRoomTypeNotFound
+	}
+
+	return calendars, nil
+}
+
+// StayCalendars Returns the inventory of the hotel's room types for the
+// nights of a stay from checkIn until checkOut along with its departure day
+func (s *InventoryService) StayCalendars(
+	ctx context.Context, hotelID uuid.UUID, checkIn, checkOut time.Time,
+) ([]RoomTypeCalendar, error) {
+	calendars, err := s.inventoryRepo.GetCalendars(ctx, hotelID, nil, checkIn, checkOut.AddDate(0, 0, 1))
+	if err != nil {
+		s.log.Errorw("error retrieving hotel inventory", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return calendars, nil
+}
+
+// UpdateInventory Applies the update to the nights of the room type between
+// startDate and endDate, both included, and returns their inventory
+func (s *InventoryService) UpdateInventory(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	roomTypeID uuid.UUID,
+	startDate time.Time,
+	endDate time.Time,
+	update Update,
+) (*RoomTypeCalendar, error) {
+	if endDate.Before(startDate) || !endDate.Before(startDate.AddDate(0, 0, MaxCalendarNights)) {
+		return nil, ErrInvalidUpdateRange
+	}
+
+	if _, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false); err != nil {
+		return nil, err
+	}
+
+	event := core.DomainEvent{
+		Type:          core.InventoryUpdated,
+		HotelID:       hotelID,
+		AggregateType: aggregateType,
+		AggregateID:   roomTypeID,
+		Payload: InventoryUpdatedPayload{
+			RoomTypeID: roomTypeID,
+			StartDate:  startDate.Format(time.DateOnly),
+			EndDate:    endDate.Format(time.DateOnly),
+		},
+	}
+	if err := s.inventoryRepo.Update(ctx, hotelID, roomTypeID, startDate, endDate, update, event); err != nil {
+		s.log.Errorw("error updating inventory", "hotelID", hotelID, "roomTypeID", roomTypeID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("inventory updated successfully", "hotelID", hotelID, "roomTypeID", roomTypeID)
+
+	calendars, err := s.Calendar(ctx, hotelID, &roomTypeID, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return &calendars[0], nil
+}
@@ -0,0 +1,116 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type CreateTicketRequest struct {
+	Category    string `json:"category" validate:"required,oneof=plumbing electrical hvac furniture appliance structural other"`
+	Severity    string `json:"severity" validate:"required,oneof=low medium high critical"`
+	Description string `json:"description" validate:"required,max=4096"`
+	Assignee    string `json:"assignee" validate:"max=256"`
+}
+
+type UpdateTicketRequest struct {
+	Category    *string `json:"category" validate:"omitempty,oneof=plumbing electrical hvac furniture appliance structural other"`
+	Severity    *string `json:"severity" validate:"omitempty,oneof=low medium high critical"`
+	Description *string `json:"description" validate:"omitempty,max=4096"`
+	Assignee    *string `json:"assignee" validate:"omitempty,max=256"`
+	Status      *string `json:"status" validate:"omitempty,oneof=open in_progress resolved closed"`
+}
+
+type CreateBlockRequest struct {
+	StartDate string     `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string     `json:"end_date" validate:"required,datetime=2006-01-02"`
+	TicketID  *uuid.UUID `json:"ticket_id"`
+	Reason    string     `json:"reason" validate:"max=512"`
+}
+
+type TicketResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   string    `json:"created_at"`
+	UpdatedAt   string    `json:"updated_at"`
+	HotelID     uuid.UUID `json:"hotel_id"`
+	RoomID      uuid.UUID `json:"room_id"`
+	Category    string    `json:"category"`
+	Severity    string    `json:"severity"`
+	Description string    `json:"description"`
+	Reporter    string    `json:"reporter"`
+	Assignee    string    `json:"assignee,omitempty"`
+	Status      string    `json:"status"`
+	ResolvedAt  string    `json:"resolved_at,omitempty"`
+}
+
+type ListTicketsResponse struct {
+	Results    []TicketResponse `json:"results"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+type BlockResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt string     `json:"created_at"`
+	RoomID    uuid.UUID  `json:"room_id"`
+	TicketID  *uuid.UUID `json:"ticket_id,omitempty"`
+	StartDate string     `json:"start_date"`
+	EndDate   string     `json:"end_date"`
+	Reason    string     `json:"reason,omitempty"`
+	CreatedBy string     `json:"created_by"`
+}
+
+type ListBlocksResponse struct {
+	Results []BlockResponse `json:"results"`
+}
+
+func NewTicketResponse(t *Ticket) TicketResponse {
+	resp := TicketResponse{
+		ID:          t.ID,
+		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   t.UpdatedAt.Format(time.RFC3339),
+		HotelID:     t.HotelID,
+		RoomID:      t.RoomID,
+		Category:    t.Category,
+		Severity:    t.Severity,
+		Description: t.Description,
+		Reporter:    t.Reporter,
+		Assignee:    t.Assignee,
+		Status:      t.Status,
+	}
+	if !t.ResolvedAt.IsZero() {
+		resp.ResolvedAt = t.ResolvedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func NewListTicketsResponse(tickets Tickets, nextCursor string) ListTicketsResponse {
+	responses := make([]TicketResponse, len(tickets))
+	for i, ticket := range tickets {
+		responses[i] = NewTicketResponse(&ticket)
+	}
+	return ListTicketsResponse{
+		Results:    responses,
+		NextCursor: nextCursor,
+	}
+}
+
+func NewBlockResponse(b *Block) BlockResponse {
+	return BlockResponse{
+		ID:        b.ID,
+		CreatedAt: b.CreatedAt.Format(time.RFC3339),
+		RoomID:    b.RoomID,
+		TicketID:  b.TicketID,
+		StartDate: b.StartDate.Format(time.DateOnly),
+		EndDate:   b.EndDate.Format(time.DateOnly),
+		Reason:    b.Reason,
+		CreatedBy: b.CreatedBy,
+	}
+}
+
+func NewListBlocksResponse(blocks Blocks) ListBlocksResponse {
+	responses := make([]BlockResponse, len(blocks))
+	for i, block := range blocks {
+		responses[i] = NewBlockResponse(&block)
+	}
+	return ListBlocksResponse{Results: responses}
+}
@@ -0,0 +1,26 @@
+package maintenance
+
+import (
+	"fmt"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrTicketNotFound    = terrors.NotFound("maintenance_ticket", "maintenance ticket not found", nil)
+	ErrBlockNotFound     = terrors.NotFound("room_block", "out of order block not found", nil)
+	ErrInvalidBlockDates = terrors.BadRequest(
+		"block_dates",
+		fmt.Sprintf("end_date must be after start_date and at most %d nights after it", MaxBlockNights),
+		nil,
+	)
+	ErrBlockInThePast = terrors.BadRequest("block_dates", "end_date must be after today", nil)
+	ErrBlockOverlaps  = core.Conflict(
+		"room_block_overlaps", "room is already out of order for some of the requested nights", nil,
+	)
+	ErrRoomReserved = core.Conflict(
+		"room_reserved", "room has reservations on some of the requested nights, move them first", nil,
+	)
+)
@@ -0,0 +1,336 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type MaintenanceController struct {
+	validator          *validator.Validate
+	maintenanceService *MaintenanceService
+	log                *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	maintenanceService *MaintenanceService,
+	membershipChecker auth.HotelMembershipChecker,
+) *MaintenanceController {
+	c := &MaintenanceController{
+		validator:          validator,
+		maintenanceService: maintenanceService,
+		log:                logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance", c.handleListTickets)
+		r.Get("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/blocks", c.handleListBlocks)
+		r.Get("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/{ticket_id}", c.handleGetTicket)
+	})
+
+	// Any member of the hotel reports and works maintenance tickets
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance", c.handleCreateTicket)
+		r.Patch("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/{ticket_id}", c.handleUpdateTicket)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Delete("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/{ticket_id}", c.handleDeleteTicket)
+		r.Post("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/blocks", c.handleCreateBlock)
+		r.Delete("/v1/hotels/{hotel_id}/rooms/{room_id}/maintenance/blocks/{block_id}", c.handleDeleteBlock)
+	})
+
+	return c
+}
+
+func (c *MaintenanceController) handleListTickets(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := TicketFilters{
+		Status:   query.Get("status"),
+		Severity: query.Get("severity"),
+	}
+
+	tickets, nextCursor, err := c.maintenanceService.ListTickets(r.Context(), hotelID, roomID, filters, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListTicketsResponse(tickets, nextCursor)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *MaintenanceController) handleGetTicket(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+	ticketID, ok := c.ticketID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := c.maintenanceService.RetrieveTicket(r.Context(), hotelID, roomID, ticketID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTicketResponse(ticket)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *MaintenanceController) handleCreateTicket(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CreateTicketRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	ticket, err := NewTicket(
+		hotelID,
+		roomID,
+		payload.Category,
+		payload.Severity,
+		payload.Description,
+		core.ActorFromContext(r.Context()).Principal,
+		payload.Assignee,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating maintenance ticket instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	ticket, err = c.maintenanceService.OpenTicket(r.Context(), ticket)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTicketResponse(ticket)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *MaintenanceController) handleUpdateTicket(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+	ticketID, ok := c.ticketID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload UpdateTicketRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	ticket, err := c.maintenanceService.UpdateTicket(
+		r.Context(),
+		hotelID,
+		roomID,
+		ticketID,
+		payload.Category,
+		payload.Severity,
+		payload.Description,
+		payload.Assignee,
+		payload.Status,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTicketResponse(ticket)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *MaintenanceController) handleDeleteTicket(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+	ticketID, ok := c.ticketID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.maintenanceService.DeleteTicket(r.Context(), hotelID, roomID, ticketID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *MaintenanceController) handleListBlocks(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+
+	blocks, err := c.maintenanceService.ListBlocks(r.Context(), hotelID, roomID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListBlocksResponse(blocks)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *MaintenanceController) handleCreateBlock(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CreateBlockRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	// Dates were already validated against the layout
+	startDate, _ := time.Parse(time.DateOnly, payload.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, payload.EndDate)
+
+	block, err := NewBlock(
+		hotelID,
+		roomID,
+		payload.TicketID,
+		startDate,
+		endDate,
+		payload.Reason,
+		core.ActorFromContext(r.Context()).Principal,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating room block instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	block, err = c.maintenanceService.CreateBlock(r.Context(), block)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewBlockResponse(block)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *MaintenanceController) handleDeleteBlock(w http.ResponseWriter, r *http.Request) {
+	hotelID, roomID, ok := c.hotelRoomIDs(w, r)
+	if !ok {
+		return
+	}
+
+	blockID := chi.URLParam(r, "block_id")
+	uuidBlockID, err := uuid.FromString(blockID)
+	if err != nil {
+		c.log.Errorw("invalid block id", "blockID", blockID, "error", err)
+		rest.RenderError(r.Context(), w, ErrBlockNotFound)
+		return
+	}
+
+	if err := c.maintenanceService.DeleteBlock(r.Context(), hotelID, roomID, uuidBlockID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *MaintenanceController) hotelRoomIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	roomID := chi.URLParam(r, "room_id")
+	uuidRoomID, err := uuid.FromString(roomID)
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", roomID, "error", err)
+		rest.RenderError(r.Context(), w, room.ErrRoomNotFound)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return uuidHotelID, uuidRoomID, true
+}
+
+func (c *MaintenanceController) ticketID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ticketID := chi.URLParam(r, "ticket_id")
+	uuidTicketID, err := uuid.FromString(ticketID)
+	if err != nil {
+		c.log.Errorw("invalid ticket id", "ticketID", ticketID, "error", err)
+		rest.RenderError(r.Context(), w, ErrTicketNotFound)
+		return uuid.Nil, false
+	}
+	return uuidTicketID, true
+}
+
+// decode Reads and validates the request body into payload
+func (c *MaintenanceController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package maintenance
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type Severity string
+
+const (
+	LOW      Severity = "low"
+	MEDIUM   Severity = "medium"
+	HIGH     Severity = "high"
+	CRITICAL Severity = "critical"
+)
+
+type Status string
+
+const (
+	OPEN        Status = "open"
+	IN_PROGRESS Status = "in_progress"
+	RESOLVED    Status = "resolved"
+	CLOSED      Status = "closed"
+)
+
+// MaxBlockNights longest range of nights a room is put out of order at once
+const MaxBlockNights = 366
+
+// --------------------
+// DB models
+// --------------------
+
+// Ticket maintenance work reported on a room
+type Ticket struct {
+	bun.BaseModel `bun:"table:maintenance_tickets"`
+	ID            uuid.UUID `bun:"id,pk"`
+	CreatedAt     time.Time `bun:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	RoomID        uuid.UUID `bun:"room_id"`
+	Category      string    `bun:"category"`
+	Severity      string    `bun:"severity"`
+	Description   string    `bun:"description"`
+	Reporter      string    `bun:"reporter"`
+	Assignee      string    `bun:"assignee"`
+	Status        string    `bun:"status"`
+	ResolvedAt    time.Time `bun:"resolved_at,nullzero"`
+}
+
+type Tickets []Ticket
+
+// TicketFilters narrows down ticket listings, empty fields are ignored
+type TicketFilters struct {
+	Status   string
+	Severity string
+}
+
+// SortableColumns public sort keys of ticket listings and their columns
+var SortableColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// DefaultSort lists the most recent tickets first
+const DefaultSort = "-created_at"
+
+func cursorKey(ticket Ticket, column string) (string, uuid.UUID) {
+	if column == "updated_at" {
+		return ticket.UpdatedAt.Format(time.RFC3339Nano), ticket.ID
+	}
+	return ticket.CreatedAt.Format(time.RFC3339Nano), ticket.ID
+}
+
+// Block nights a room is out of order and can not be sold, from StartDate
+// until the night before EndDate
+type Block struct {
+	bun.BaseModel `bun:"table:room_blocks"`
+	ID            uuid.UUID  `bun:"id,pk"`
+	CreatedAt     time.Time  `bun:"created_at"`
+	HotelID       uuid.UUID  `bun:"hotel_id"`
+	RoomID        uuid.UUID  `bun:"room_id"`
+	TicketID      *uuid.UUID `bun:"ticket_id"`
+	StartDate     time.Time  `bun:"start_date,type:date"`
+	EndDate       time.Time  `bun:"end_date,type:date"`
+	Reason        string     `bun:"reason"`
+	CreatedBy     string     `bun:"created_by"`
+}
+
+type Blocks []Block
+
+func NewTicket(
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	category string,
+	severity string,
+	description string,
+	reporter string,
+	assignee string,
+) (*Ticket, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ticket{
+		ID:          id,
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+		HotelID:     hotelID,
+		RoomID:      roomID,
+		Category:    category,
+		Severity:    severity,
+		Description: description,
+		Reporter:    reporter,
+		Assignee:    assignee,
+		Status:      string(OPEN),
+	}, nil
+}
+
+// SetStatus Moves the ticket into status, tickets are resolved when they
+// reach resolved or closed and reopened when they leave them
+func (t *Ticket) SetStatus(status string) {
+	if status == t.Status {
+		return
+	}
+	t.Status = status
+	if !t.IsResolved() {
+		t.ResolvedAt = time.Time{}
+	} else if t.ResolvedAt.IsZero() {
+		t.ResolvedAt = time.Now().UTC()
+	}
+}
+
+// IsResolved Reports whether the work of the ticket is finished
+func (t *Ticket) IsResolved() bool {
+	return slices.Contains([]Status{RESOLVED, CLOSED}, Status(t.Status))
+}
+
+func NewBlock(
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	ticketID *uuid.UUID,
+	startDate time.Time,
+	endDate time.Time,
+	reason string,
+	createdBy string,
+) (*Block, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Block{
+		ID:        id,
+		CreatedAt: time.Now().UTC(),
+		HotelID:   hotelID,
+		RoomID:    roomID,
+		TicketID:  ticketID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    reason,
+		CreatedBy: createdBy,
+	}, nil
+}
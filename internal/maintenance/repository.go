@@ -0,0 +1,192 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+// exclusionViolation is the Postgres error code raised by the
+// room_blocks_room_dates_excl constraint.
+const exclusionViolation = "23P01"
+
+type MaintenanceRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *MaintenanceRepository {
+	return &MaintenanceRepository{
+		db: db,
+	}
+}
+
+func (r *MaintenanceRepository) SaveTicket(ctx context.Context, ticket *Ticket) error {
+	_, err := r.db.NewInsert().
+		Model(ticket).
+		Exec(ctx)
+	return err
+}
+
+func (r *MaintenanceRepository) UpdateTicket(ctx context.Context, ticket *Ticket) error {
+	_, err := r.db.NewUpdate().
+		Model(ticket).
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// DeleteTicket Deletes the ticket, its blocks are kept and no longer refer
+// to it
+func (r *MaintenanceRepository) DeleteTicket(ctx context.Context, id uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*Block)(nil)).
+			Set("ticket_id = NULL").
+			Where("ticket_id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.NewDelete().
+			Model((*Ticket)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *MaintenanceRepository) GetTicketByID(ctx context.Context, id uuid.UUID) (*Ticket, error) {
+	var ticket Ticket
+	err := r.db.NewSelect().
+		Model(&ticket).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// GetTicketsByRoomID Returns a page of the room's tickets matching the
+// filters along with the cursor of the next page
+func (r *MaintenanceRepository) GetTicketsByRoomID(
+	ctx context.Context, roomID uuid.UUID, filters TicketFilters, page pagination.Params,
+) (Tickets, string, error) {
+	var tickets Tickets
+	q := r.db.NewSelect().
+		Model(&tickets).
+		Where("room_id = ?", roomID)
+
+	if filters.Status != "" {
+		q = q.Where("status = ?", filters.Status)
+	}
+	if filters.Severity != "" {
+		q = q.Where("severity = ?", filters.Severity)
+	}
+
+	err := page.Apply(q).Scan(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tickets, nextCursor := pagination.Paginate(tickets, page, cursorKey)
+
+	return tickets, nextCursor, nil
+}
+
+// SaveBlock Stores the block unless the room has active reservations on its
+// nights, and stores the events in the outbox within the same transaction.
+// Locking the room serializes the block with the room's new reservations.
+func (r *MaintenanceRepository) SaveBlock(ctx context.Context, block *Block, events ...core.DomainEvent) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewSelect().
+			TableExpr("rooms").
+			ColumnExpr("id").
+			Where("id = ?", block.RoomID).
+			For("UPDATE").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		reserved, err := tx.NewSelect().
+			TableExpr("reservations").
+			Where("room_id = ?", block.RoomID).
+			Where("status <> 'cancelled'").
+			Where("daterange(check_in, check_out, '[)') && daterange(?::date, ?::date, '[)')", block.StartDate, block.EndDate).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if reserved {
+			return ErrRoomReserved
+		}
+
+		if _, err := tx.NewInsert().Model(block).Exec(ctx); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+				return ErrBlockOverlaps
+			}
+			return err
+		}
+
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// DeleteBlock Deletes the block and stores the events in the outbox within
+// the same transaction
+func (r *MaintenanceRepository) DeleteBlock(ctx context.Context, id uuid.UUID, events ...core.DomainEvent) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*Block)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+func (r *MaintenanceRepository) GetBlockByID(ctx context.Context, id uuid.UUID) (*Block, error) {
+	var block Block
+	err := r.db.NewSelect().
+		Model(&block).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetBlocksByRoomID Returns the room's blocks that did not end yet sorted by
+// start date
+func (r *MaintenanceRepository) GetBlocksByRoomID(ctx context.Context, roomID uuid.UUID) (Blocks, error) {
+	var blocks Blocks
+	err := r.db.NewSelect().
+		Model(&blocks).
+		Where("room_id = ?", roomID).
+		Where("end_date > CURRENT_DATE").
+		Order("start_date ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
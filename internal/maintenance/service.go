@@ -0,0 +1,228 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/inventory"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+// inventoryAggregateType aggregate type of the inventory domain events
+// raised when rooms go out of order
+const inventoryAggregateType = "room_type_inventory"
+
+type MaintenanceService struct {
+	maintenanceRepo *MaintenanceRepository
+	roomService     *room.RoomService
+	log             *zap.SugaredLogger
+}
+
+func NewService(maintenanceRepo *MaintenanceRepository, roomService *room.RoomService) *MaintenanceService {
+	return &MaintenanceService{
+		maintenanceRepo: maintenanceRepo,
+		roomService:     roomService,
+		log:             logger.GetLogger(),
+	}
+}
+
+func (s *MaintenanceService) ListTickets(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, filters TicketFilters, page pagination.Params,
+) (Tickets, string, error) {
+	if _, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true); err != nil {
+		return nil, "", err
+	}
+
+	tickets, nextCursor, err := s.maintenanceRepo.GetTicketsByRoomID(ctx, roomID, filters, page)
+	if err != nil {
+		s.log.Errorw("error retrieving maintenance tickets", "roomID", roomID, "error", err)
+		return nil, "", err
+	}
+	return tickets, nextCursor, nil
+}
+
+func (s *MaintenanceService) RetrieveTicket(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, ticketID uuid.UUID,
+) (*Ticket, error) {
+	ticket, err := s.maintenanceRepo.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		s.log.Errorw("error retrieving maintenance ticket", "ticketID", ticketID, "error", err)
+		return nil, err
+	}
+	if ticket == nil || ticket.HotelID != hotelID || ticket.RoomID != roomID {
+		return nil, ErrTicketNotFound
+	}
+	return ticket, nil
+}
+
+func (s *MaintenanceService) OpenTicket(ctx context.Context, t *Ticket) (*Ticket, error) {
+	if _, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, t.HotelID, t.RoomID, false); err != nil {
+		return nil, err
+	}
+
+	if err := s.maintenanceRepo.SaveTicket(ctx, t); err != nil {
+		s.log.Errorw("error opening maintenance ticket", "roomID", t.RoomID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("maintenance ticket opened successfully", "ticketID", t.ID, "roomID", t.RoomID)
+
+	return t, nil
+}
+
+func (s *MaintenanceService) UpdateTicket(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	ticketID uuid.UUID,
+	category *string,
+	severity *string,
+	description *string,
+	assignee *string,
+	status *string,
+) (*Ticket, error) {
+	ticket, err := s.RetrieveTicket(ctx, hotelID, roomID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category != nil {
+		ticket.Category = *category
+	}
+	if severity != nil {
+		ticket.Severity = *severity
+	}
+	if description != nil {
+		ticket.Description = *description
+	}
+	if assignee != nil {
+		ticket.Assignee = *assignee
+	}
+	if status != nil {
+		ticket.SetStatus(*status)
+	}
+	ticket.UpdatedAt = time.Now().UTC()
+
+	if err := s.maintenanceRepo.UpdateTicket(ctx, ticket); err != nil {
+		s.log.Errorw("failure updating maintenance ticket", "ticketID", ticketID, "error", err)
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+func (s *MaintenanceService) DeleteTicket(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, ticketID uuid.UUID,
+) error {
+	ticket, err := s.RetrieveTicket(ctx, hotelID, roomID, ticketID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.maintenanceRepo.DeleteTicket(ctx, ticket.ID); err != nil {
+		s.log.Errorw("failure deleting maintenance ticket", "ticketID", ticketID, "error", err)
+		return err
+	}
+
+	s.log.Infow("maintenance ticket deleted successfully", "ticketID", ticketID)
+
+	return nil
+}
+
+// ListBlocks Returns the blocks of the room that did not end yet
+func (s *MaintenanceService) ListBlocks(ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID) (Blocks, error) {
+	if _, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true); err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.maintenanceRepo.GetBlocksByRoomID(ctx, roomID)
+	if err != nil {
+		s.log.Errorw("error retrieving room blocks", "roomID", roomID, "error", err)
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// CreateBlock Puts the room out of order from the block's start date until
+// the night before its end date. Blocked nights are neither available nor
+// sellable, so rooms with reservations on them can not be blocked.
+func (s *MaintenanceService) CreateBlock(ctx context.Context, b *Block) (*Block, error) {
+	if !b.EndDate.After(b.StartDate) || b.EndDate.After(b.StartDate.AddDate(0, 0, MaxBlockNights)) {
+		return nil, ErrInvalidBlockDates
+	}
+	if !b.EndDate.After(time.Now().UTC()) {
+		return nil, ErrBlockInThePast
+	}
+
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, b.HotelID, b.RoomID, false)
+	if err != nil {
+		return nil, err
+	}
+	if b.TicketID != nil {
+		if _, err := s.RetrieveTicket(ctx, b.HotelID, b.RoomID, *b.TicketID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.maintenanceRepo.SaveBlock(ctx, b, newInventoryEvent(r, b)); err != nil {
+		s.log.Errorw("error saving room block", "roomID", b.RoomID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow(
+		"room put out of order successfully",
+		"roomID", b.RoomID, "from", b.StartDate.Format(time.DateOnly), "to", b.EndDate.Format(time.DateOnly),
+	)
+
+	return b, nil
+}
+
+// DeleteBlock Puts the room's blocked nights back on sale
+func (s *MaintenanceService) DeleteBlock(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, blockID uuid.UUID,
+) error {
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true)
+	if err != nil {
+		return err
+	}
+
+	block, err := s.maintenanceRepo.GetBlockByID(ctx, blockID)
+	if err != nil {
+		s.log.Errorw("error retrieving room block", "blockID", blockID, "error", err)
+		return err
+	}
+	if block == nil || block.RoomID != roomID {
+		return ErrBlockNotFound
+	}
+
+	if err := s.maintenanceRepo.DeleteBlock(ctx, block.ID, newInventoryEvent(r, block)); err != nil {
+		s.log.Errorw("failure deleting room block", "blockID", blockID, "error", err)
+		return err
+	}
+
+	s.log.Infow("room block deleted successfully", "roomID", roomID, "blockID", blockID)
+
+	return nil
+}
+
+// newInventoryEvent Domain event of the inventory of the room's type
+// changing on the nights of the block
+func newInventoryEvent(r *room.Room, block *Block) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          core.InventoryUpdated,
+		HotelID:       r.HotelID,
+		AggregateType: inventoryAggregateType,
+		AggregateID:   r.RoomTypeID,
+		Payload: inventory.InventoryUpdatedPayload{
+			RoomTypeID: r.RoomTypeID,
+			StartDate:  block.StartDate.Format(time.DateOnly),
+			EndDate:    block.EndDate.AddDate(0, 0, -1).Format(time.DateOnly),
+		},
+	}
+}
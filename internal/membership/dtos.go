@@ -0,0 +1,46 @@
+package membership
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type CreateMembershipRequest struct {
+	Principal string `json:"principal" validate:"required,max=256"`
+	Role      string `json:"role" validate:"required,oneof=viewer manager"`
+}
+
+type MembershipResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+	Principal string    `json:"principal"`
+	HotelID   uuid.UUID `json:"hotel_id"`
+	Role      string    `json:"role"`
+}
+
+type ListMembershipsResponse struct {
+	Results []MembershipResponse `json:"results"`
+}
+
+func NewMembershipResponse(m *Membership) MembershipResponse {
+	return MembershipResponse{
+		ID:        m.ID,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: m.UpdatedAt.Format(time.RFC3339),
+		Principal: m.Principal,
+		HotelID:   m.HotelID,
+		Role:      m.Role,
+	}
+}
+
+func NewListMembershipsResponse(memberships Memberships) ListMembershipsResponse {
+	responses := make([]MembershipResponse, len(memberships))
+	for i, membership := range memberships {
+		responses[i] = NewMembershipResponse(&membership)
+	}
+	return ListMembershipsResponse{
+		Results: responses,
+	}
+}
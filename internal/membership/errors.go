@@ -0,0 +1,9 @@
+package membership
+
+import "github.com/monzo/terrors"
+
+var (
+	ErrMembershipNotFound = terrors.NotFound("membership", "principal is not a member of the hotel", nil)
+	ErrInvalidRole        = terrors.BadRequest("role", "role must be one of viewer, manager", nil)
+	ErrHotelNotFound      = terrors.NotFound("hotel", "hotel does not exist", nil)
+)
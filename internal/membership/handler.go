@@ -0,0 +1,123 @@
+package membership
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type MembershipController struct {
+	validator         *validator.Validate
+	membershipService *MembershipService
+	log               *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	membershipService *MembershipService,
+) *MembershipController {
+	c := &MembershipController{
+		validator:         validator,
+		membershipService: membershipService,
+		log:               logger.GetLogger(),
+	}
+
+	// Only administrators decide who operates each hotel
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleAdmin))
+		r.Get("/v1/hotels/{hotel_id}/members", c.handleListHotelMembers)
+		r.Post("/v1/hotels/{hotel_id}/members", c.handleGrantHotelMembership)
+		r.Delete("/v1/hotels/{hotel_id}/members/{principal}", c.handleRevokeHotelMembership)
+	})
+
+	return c
+}
+
+func (c *MembershipController) handleListHotelMembers(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	memberships, err := c.membershipService.ListMembershipsByHotelID(r.Context(), uuidHotelID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListMembershipsResponse(memberships)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *MembershipController) handleGrantHotelMembership(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	var payload CreateMembershipRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	membership, err := NewMembership(payload.Principal, uuidHotelID, payload.Role)
+	if err != nil {
+		c.log.Errorw("failure creating membership instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	membership, err = c.membershipService.GrantMembership(r.Context(), membership)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewMembershipResponse(membership)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *MembershipController) handleRevokeHotelMembership(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	principal := chi.URLParam(r, "principal")
+
+	if err := c.membershipService.RevokeMembership(r.Context(), principal, uuidHotelID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,54 @@
+package membership
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type Role string
+
+const (
+	// VIEWER can read the hotel and its inventory
+	VIEWER Role = "viewer"
+	// MANAGER can read and modify the hotel and its inventory
+	MANAGER Role = "manager"
+)
+
+// --------------------
+// DB models
+// --------------------
+type Membership struct {
+	bun.BaseModel `bun:"table:hotel_memberships"`
+	ID            uuid.UUID `bun:"id"`
+	CreatedAt     time.Time `bun:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	Principal     string    `bun:"principal"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	Role          string    `bun:"role"`
+}
+
+type Memberships []Membership
+
+func NewMembership(
+	principal string,
+	hotelID uuid.UUID,
+	role string,
+) (*Membership, error) {
+	now := time.Now().UTC()
+
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Membership{
+		ID:        id,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Principal: principal,
+		HotelID:   hotelID,
+		Role:      role,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package membership
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+// foreignKeyViolation is the Postgres error code raised when hotel_id does
+// not reference an existing hotel.
+const foreignKeyViolation = "23503"
+
+type MembershipRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *MembershipRepository {
+	return &MembershipRepository{
+		db: db,
+	}
+}
+
+// Save Creates the membership or updates the role of an existing one for
+// the same principal and hotel.
+func (r *MembershipRepository) Save(ctx context.Context, membership *Membership) error {
+	_, err := r.db.NewInsert().
+		Model(membership).
+		On("CONFLICT (principal, hotel_id) DO UPDATE").
+		Set("role = EXCLUDED.role").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id, created_at").
+		Exec(ctx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ErrHotelNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *MembershipRepository) Delete(ctx context.Context, principal string, hotelID uuid.UUID) (bool, error) {
+	res, err := r.db.NewDelete().
+		Model((*Membership)(nil)).
+		Where("principal = ? AND hotel_id = ?", principal, hotelID).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *MembershipRepository) GetByPrincipalHotelID(
+	ctx context.Context, principal string, hotelID uuid.UUID,
+) (*Membership, error) {
+	var membership Membership
+	err := r.db.NewSelect().
+		Model(&membership).
+		Where("principal = ? AND hotel_id = ?", principal, hotelID).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (r *MembershipRepository) GetByHotelID(ctx context.Context, hotelID uuid.UUID) (Memberships, error) {
+	var memberships Memberships
+	err := r.db.NewSelect().
+		Model(&memberships).
+		Where("hotel_id = ?", hotelID).
+		Order("principal ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func (r *MembershipRepository) GetHotelIDsByPrincipal(ctx context.Context, principal string) ([]uuid.UUID, error) {
+	var hotelIDs []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*Membership)(nil)).
+		Column("hotel_id").
+		Where("principal = ?", principal).
+		Scan(ctx, &hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+	return hotelIDs, nil
+}
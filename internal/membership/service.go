@@ -0,0 +1,84 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type MembershipService struct {
+	membershipRepo *MembershipRepository
+	log            *zap.SugaredLogger
+}
+
+func NewService(membershipRepo *MembershipRepository) *MembershipService {
+	return &MembershipService{
+		membershipRepo: membershipRepo,
+		log:            logger.GetLogger(),
+	}
+}
+
+func (s *MembershipService) ListMembershipsByHotelID(ctx context.Context, hotelID uuid.UUID) (Memberships, error) {
+	memberships, err := s.membershipRepo.GetByHotelID(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving memberships by hotel ID", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func (s *MembershipService) GrantMembership(ctx context.Context, m *Membership) (*Membership, error) {
+	if Role(m.Role) != VIEWER && Role(m.Role) != MANAGER {
+		return nil, ErrInvalidRole
+	}
+
+	if err := s.membershipRepo.Save(ctx, m); err != nil {
+		s.log.Errorw("error granting hotel membership", "principal", m.Principal, "hotelID", m.HotelID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("hotel membership granted", "principal", m.Principal, "hotelID", m.HotelID, "role", m.Role)
+
+	return m, nil
+}
+
+func (s *MembershipService) RevokeMembership(ctx context.Context, principal string, hotelID uuid.UUID) error {
+	deleted, err := s.membershipRepo.Delete(ctx, principal, hotelID)
+	if err != nil {
+		s.log.Errorw("error revoking hotel membership", "principal", principal, "hotelID", hotelID, "error", err)
+		return err
+	}
+	if !deleted {
+		return ErrMembershipNotFound
+	}
+
+	s.log.Infow("hotel membership revoked", "principal", principal, "hotelID", hotelID)
+
+	return nil
+}
+
+// GetHotelRole Returns the role the principal holds in the hotel, or an
+// empty string when it is not a member.
+func (s *MembershipService) GetHotelRole(ctx context.Context, principal string, hotelID uuid.UUID) (string, error) {
+	membership, err := s.membershipRepo.GetByPrincipalHotelID(ctx, principal, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving hotel membership", "principal", principal, "hotelID", hotelID, "error", err)
+		return "", err
+	}
+	if membership == nil {
+		return "", nil
+	}
+	return membership.Role, nil
+}
+
+func (s *MembershipService) ListMemberHotelIDs(ctx context.Context, principal string) ([]uuid.UUID, error) {
+	hotelIDs, err := s.membershipRepo.GetHotelIDsByPrincipal(ctx, principal)
+	if err != nil {
+		s.log.Errorw("error retrieving principal hotels", "principal", principal, "error", err)
+		return nil, err
+	}
+	return hotelIDs, nil
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// baseRetryDelay delay before retrying a message that failed once, it
+	// doubles on every further failure up to maxRetryDelay
+	baseRetryDelay = time.Second
+	maxRetryDelay  = 10 * time.Minute
+)
+
+// --------------------
+// DB models
+// --------------------
+type Message struct {
+	bun.BaseModel `bun:"table:outbox"`
+	ID            uuid.UUID       `bun:"id"`
+	CreatedAt     time.Time       `bun:"created_at"`
+	EventType     string          `bun:"event_type"`
+	HotelID       uuid.UUID       `bun:"hotel_id"`
+	AggregateType string          `bun:"aggregate_type"`
+	AggregateID   uuid.UUID       `bun:"aggregate_id"`
+	Payload       json.RawMessage `bun:"payload,type:jsonb"`
+	Attempts      int             `bun:"attempts"`
+	LastError     string          `bun:"last_error,nullzero"`
+	AvailableAt   time.Time       `bun:"available_at"`
+	LockedUntil   time.Time       `bun:"locked_until,nullzero"`
+	PublishedAt   time.Time       `bun:"published_at,nullzero"`
+	DeadAt        time.Time       `bun:"dead_at,nullzero"`
+}
+
+type Messages []Message
+
+func NewMessage(event core.DomainEvent) (*Message, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Message{
+		ID:            id,
+		CreatedAt:     now,
+		EventType:     event.Type,
+		HotelID:       event.HotelID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		Payload:       payload,
+		AvailableAt:   now,
+	}, nil
+}
+
+// retryDelay Exponential backoff after the message failed attempts times
+func retryDelay(attempts int) time.Duration {
+	delay := float64(baseRetryDelay) * math.Pow(2, float64(attempts-1))
+	if delay > float64(maxRetryDelay) {
+		return maxRetryDelay
+	}
+	return time.Duration(delay)
+}
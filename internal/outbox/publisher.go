@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	LogPublisherName     = "log"
+	WebhookPublisherName = "webhook"
+)
+
+// Publisher delivers outbox messages to downstream systems. Messages may be
+// delivered more than once and out of order, consumers deduplicate them by ID
+// and order the events of an aggregate by their occurred_at.
+type Publisher interface {
+	Publish(ctx context.Context, message *Message) error
+}
+
+// Envelope wire representation of a published event
+type Envelope struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          string          `json:"type"`
+	OccurredAt    string          `json:"occurred_at"`
+	HotelID       uuid.UUID       `json:"hotel_id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id"`
+	Data          json.RawMessage `json:"data"`
+}
+
+func NewEnvelope(m *Message) Envelope {
+	return Envelope{
+		ID:            m.ID,
+		Type:          m.EventType,
+		OccurredAt:    m.CreatedAt.Format(time.RFC3339Nano),
+		HotelID:       m.HotelID,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		Data:          m.Payload,
+	}
+}
+
+// NewPublisher Returns the publisher selected in the configuration, the log
+// publisher by default
+func NewPublisher(outboxConf config.OutboxConfigurations) (Publisher, error) {
+	switch outboxConf.Publisher {
+	case "", LogPublisherName:
+		return NewLogPublisher(), nil
+	case WebhookPublisherName:
+		return NewWebhookPublisher(outboxConf.WebhookURL, outboxConf.WebhookSecret)
+	}
+	return nil, fmt.Errorf("unknown outbox publisher %q", outboxConf.Publisher)
+}
+
+// LogPublisher writes every event to the application log, meant for local
+// development
+type LogPublisher struct {
+	log *zap.SugaredLogger
+}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{
+		log: logger.GetLogger(),
+	}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, message *Message) error {
+	envelope, err := json.Marshal(NewEnvelope(message))
+	if err != nil {
+		return err
+	}
+
+	p.log.Infow("domain event published", "eventType", message.EventType, "event", string(envelope))
+	return nil
+}
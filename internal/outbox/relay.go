@@ -0,0 +1,168 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 100
+	defaultMaxAttempts  = 25
+	defaultLease        = time.Minute
+)
+
+// Relay polls the outbox and hands the pending messages to the publisher,
+// giving at-least-once delivery: a message is only marked as published once
+// the publisher accepted it. Messages that fail maxAttempts times are marked
+// as dead and no longer retried.
+//
+// Events are not delivered in order, not even those of the same aggregate.
+// Messages are claimed oldest first, but relays skip the ones leased by
+// another relay and a failed message waits for its backoff while the later
+// events of its aggregate are published.
+type Relay struct {
+	outboxRepo   *OutboxRepository
+	publisher    Publisher
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	lease        time.Duration
+	log          *zap.SugaredLogger
+}
+
+func NewRelay(
+	outboxRepo *OutboxRepository,
+	publisher Publisher,
+	outboxConf config.OutboxConfigurations,
+) *Relay {
+	r := &Relay{
+		outboxRepo:   outboxRepo,
+		publisher:    publisher,
+		pollInterval: outboxConf.PollInterval,
+		batchSize:    outboxConf.BatchSize,
+		maxAttempts:  outboxConf.MaxAttempts,
+		lease:        outboxConf.LeaseDuration,
+		log:          logger.GetLogger(),
+	}
+	if r.pollInterval <= 0 {
+		r.pollInterval = defaultPollInterval
+	}
+	if r.batchSize <= 0 {
+		r.batchSize = defaultBatchSize
+	}
+	if r.maxAttempts <= 0 {
+		r.maxAttempts = defaultMaxAttempts
+	}
+	if r.lease <= 0 {
+		r.lease = defaultLease
+	}
+	return r
+}
+
+// Start Relays messages until ctx is cancelled. Full batches are followed
+// by the next one right away, otherwise the relay waits a poll interval.
+func (r *Relay) Start(ctx context.Context) {
+	r.log.Infow("outbox relay started", "pollInterval", r.pollInterval, "batchSize", r.batchSize)
+
+	for {
+		if r.relayBatch(ctx) && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			r.log.Infow("outbox relay stopped")
+			return
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// relayBatch Delivers one batch of pending messages, reporting whether it
+// was full and more messages may be waiting. Messages left once the relay
+// stops or their lease elapses are claimed again later.
+func (r *Relay) relayBatch(ctx context.Context) bool {
+	messages, err := r.outboxRepo.ClaimPending(ctx, r.batchSize, r.maxAttempts, r.lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.log.Errorw("failure claiming outbox messages", "error", err)
+		}
+		return false
+	}
+
+	var delivered, failed int
+	for i := range messages {
+		message := &messages[i]
+		// Once the lease elapsed another relay may have claimed the message
+		if ctx.Err() != nil || time.Now().After(message.LockedUntil) {
+			break
+		}
+
+		if ok := r.deliver(ctx, message); ok {
+			delivered++
+		} else {
+			failed++
+		}
+	}
+	if failed > 0 {
+		r.log.Warnw("outbox messages failed to publish", "delivered", delivered, "failed", failed)
+	}
+	return len(messages) == r.batchSize
+}
+
+// deliver Publishes the message and records the outcome, rescheduling it
+// with an exponential backoff or marking it as dead when it failed
+func (r *Relay) deliver(ctx context.Context, message *Message) bool {
+	publishErr := r.publish(ctx, message)
+
+	// The message was already handed to the publisher, its outcome is
+	// recorded even when the relay is stopping
+	ctx = context.WithoutCancel(ctx)
+
+	if publishErr == nil {
+		if err := r.outboxRepo.MarkPublished(ctx, message.ID); err != nil {
+			r.log.Errorw("failure marking outbox message as published", "messageID", message.ID, "error", err)
+		}
+		return true
+	}
+
+	attempts := message.Attempts + 1
+	if attempts >= r.maxAttempts {
+		if err := r.outboxRepo.MarkDead(ctx, message.ID, attempts, publishErr.Error()); err != nil {
+			r.log.Errorw("failure marking outbox message as dead", "messageID", message.ID, "error", err)
+			return false
+		}
+		metrics.RecordDeadOutboxMessage(message.EventType)
+		r.log.Errorw(
+			"outbox message exhausted its delivery attempts and is dead",
+			"messageID", message.ID, "eventType", message.EventType,
+			"aggregateID", message.AggregateID, "attempts", attempts,
+		)
+		return false
+	}
+
+	availableAt := time.Now().UTC().Add(retryDelay(attempts))
+	if err := r.outboxRepo.Reschedule(ctx, message.ID, attempts, publishErr.Error(), availableAt); err != nil {
+		r.log.Errorw("failure rescheduling outbox message", "messageID", message.ID, "error", err)
+	}
+	return false
+}
+
+func (r *Relay) publish(ctx context.Context, message *Message) error {
+	err := r.publisher.Publish(ctx, message)
+	if err != nil {
+		r.log.Errorw(
+			"failure publishing outbox message",
+			"messageID", message.ID, "eventType", message.EventType,
+			"attempts", message.Attempts+1, "error", err,
+		)
+	}
+	return err
+}
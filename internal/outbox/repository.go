@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+// Enqueue Stores the events in the outbox using db, which is expected to be
+// the transaction of the change that produced them.
+func Enqueue(ctx context.Context, db bun.IDB, events ...core.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make(Messages, len(events))
+	for i, event := range events {
+		message, err := NewMessage(event)
+		if err != nil {
+			return err
+		}
+		messages[i] = *message
+	}
+
+	_, err := db.NewInsert().Model(&messages).Exec(ctx)
+	return err
+}
+
+type OutboxRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// ClaimPending Leases up to limit messages due for delivery, oldest first,
+// until lease elapses. The lease is taken in a short transaction and the
+// messages are published outside of it, other relays skip them until it
+// elapses and claim them again afterwards, e.g. when their relay stopped.
+func (r *OutboxRepository) ClaimPending(
+	ctx context.Context, limit int, maxAttempts int, lease time.Duration,
+) (Messages, error) {
+	var messages Messages
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&messages).
+			Where("published_at IS NULL").
+			Where("dead_at IS NULL").
+			Where("available_at <= NOW()").
+			Where("attempts < ?", maxAttempts).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.Where("locked_until IS NULL").WhereOr("locked_until <= NOW()")
+			}).
+			OrderExpr("created_at ASC, id ASC").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		lockedUntil := time.Now().UTC().Add(lease)
+		ids := make([]uuid.UUID, len(messages))
+		for i := range messages {
+			ids[i] = messages[i].ID
+			messages[i].LockedUntil = lockedUntil
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*Message)(nil)).
+			Set("locked_until = ?", lockedUntil).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// MarkPublished Records that the message was accepted by the publisher and
+// releases its lease
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewUpdate().
+		Model((*Message)(nil)).
+		Set("attempts = attempts + 1").
+		Set("published_at = ?", time.Now().UTC()).
+		Set("locked_until = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// Reschedule Records a failed attempt to publish the message and releases
+// its lease, it is claimed again once availableAt is reached
+func (r *OutboxRepository) Reschedule(
+	ctx context.Context, id uuid.UUID, attempts int, lastError string, availableAt time.Time,
+) error {
+	_, err := r.db.NewUpdate().
+		Model((*Message)(nil)).
+		Set("attempts = ?", attempts).
+		Set("last_error = ?", lastError).
+		Set("available_at = ?", availableAt).
+		Set("locked_until = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// MarkDead Records the last failed attempt to publish the message, which
+// exhausted its attempts and is never claimed again
+func (r *OutboxRepository) MarkDead(ctx context.Context, id uuid.UUID, attempts int, lastError string) error {
+	_, err := r.db.NewUpdate().
+		Model((*Message)(nil)).
+		Set("attempts = ?", attempts).
+		Set("last_error = ?", lastError).
+		Set("dead_at = ?", time.Now().UTC()).
+		Set("locked_until = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
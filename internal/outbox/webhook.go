@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// WebhookPublisher POSTs every event as JSON to a fixed URL. Any response
+// other than 2xx is a failed delivery and is retried.
+type WebhookPublisher struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func NewWebhookPublisher(url string, secret string) (*WebhookPublisher, error) {
+	if url == "" {
+		return nil, errors.New("outbox webhook publisher requires a webhook-url")
+	}
+
+	return &WebhookPublisher{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, message *Message) error {
+	body, err := json.Marshal(NewEnvelope(message))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", message.ID.String())
+	req.Header.Set("X-Event-Type", message.EventType)
+	if len(p.secret) > 0 {
+		mac := hmac.New(sha256.New, p.secret)
+		mac.Write(body)
+		req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package quote
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+type CreateQuoteRequest struct {
+	RoomTypeID uuid.UUID  `json:"room_type_id" validate:"required"`
+	RatePlanID *uuid.UUID `json:"rate_plan_id"`
+	CheckIn    string     `json:"check_in" validate:"required,datetime=2006-01-02"`
+	CheckOut   string     `json:"check_out" validate:"required,datetime=2006-01-02"`
+	Guests     int        `json:"guests" validate:"required,min=1"`
+}
+
+type NightResponse struct {
+	Date  string          `json:"date"`
+	Price decimal.Decimal `json:"price"`
+}
+
+type TaxLineResponse struct {
+	TaxID  uuid.UUID       `json:"tax_id"`
+	Name   string          `json:"name"`
+	Kind   string          `json:"kind"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+type QuoteResponse struct {
+	HotelID    uuid.UUID         `json:"hotel_id"`
+	RoomTypeID uuid.UUID         `json:"room_type_id"`
+	RatePlanID *uuid.UUID        `json:"rate_plan_id,omitempty"`
+	CheckIn    string            `json:"check_in"`
+	CheckOut   string            `json:"check_out"`
+	Guests     int               `json:"guests"`
+	Currency   string            `json:"currency"`
+	Nights     []NightResponse   `json:"nights"`
+	Subtotal   decimal.Decimal   `json:"subtotal"`
+	Taxes      []TaxLineResponse `json:"taxes"`
+	TotalTaxes decimal.Decimal   `json:"total_taxes"`
+	Total      decimal.Decimal   `json:"total"`
+}
+
+func NewQuoteResponse(q *Quote) QuoteResponse {
+	nights := make([]NightResponse, len(q.Nights))
+	for i, night := range q.Nights {
+		nights[i] = NightResponse{
+			Date:  night.Date.Format(time.DateOnly),
+			Price: night.Price,
+		}
+	}
+	taxes := make([]TaxLineResponse, len(q.Taxes))
+	for i, line := range q.Taxes {
+		taxes[i] = TaxLineResponse{
+			TaxID:  line.TaxID,
+			Name:   line.Name,
+			Kind:   line.Kind,
+			Amount: line.Amount,
+		}
+	}
+
+	return QuoteResponse{
+		HotelID:    q.HotelID,
+		RoomTypeID: q.RoomTypeID,
+		RatePlanID: q.RatePlanID,
+		CheckIn:    q.CheckIn.Format(time.DateOnly),
+		CheckOut:   q.CheckOut.Format(time.DateOnly),
+		Guests:     q.Guests,
+		Currency:   q.Currency,
+		Nights:     nights,
+		Subtotal:   q.Subtotal,
+		Taxes:      taxes,
+		TotalTaxes: q.TotalTaxes,
+		Total:      q.Total,
+	}
+}
@@ -0,0 +1,20 @@
+package quote
+
+import (
+	"fmt"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrInvalidStayDates = terrors.BadRequest(
+		"stay_dates",
+		fmt.Sprintf(
+			"check_in and check_out must be dates with check_out after check_in and at most %d nights apart",
+			MaxNights,
+		),
+		nil,
+	)
+	ErrTooManyGuests     = terrors.BadRequest("guests", "guests exceed the max occupancy of the room type", nil)
+	ErrRatePlanNotActive = terrors.BadRequest("rate_plan", "the rate plan is not active", nil)
+)
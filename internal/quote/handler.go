@@ -0,0 +1,92 @@
+package quote
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/hotel"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type QuoteController struct {
+	validator    *validator.Validate
+	quoteService *QuoteService
+	log          *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	quoteService *QuoteService,
+	membershipChecker auth.HotelMembershipChecker,
+) *QuoteController {
+	c := &QuoteController{
+		validator:    validator,
+		quoteService: quoteService,
+		log:          logger.GetLogger(),
+	}
+
+	// Quotes are not stored, pricing a stay only requires reading the rates
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/quotes", c.handleCreateQuote)
+	})
+
+	return c
+}
+
+func (c *QuoteController) handleCreateQuote(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, hotel.ErrHotelNotFound)
+		return
+	}
+
+	var payload CreateQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	// Dates were already validated against the layout
+	checkIn, _ := time.Parse(time.DateOnly, payload.CheckIn)
+	checkOut, _ := time.Parse(time.DateOnly, payload.CheckOut)
+
+	quote, err := c.quoteService.CreateQuote(
+		r.Context(),
+		uuidHotelID,
+		payload.RoomTypeID,
+		payload.RatePlanID,
+		checkIn,
+		checkOut,
+		payload.Guests,
+		r.URL.Query().Get("currency"),
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewQuoteResponse(quote)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
@@ -0,0 +1,124 @@
+package quote
+
+import (
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/rateplan"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/internal/tax"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+// MaxNights longest stay a quote can price
+const MaxNights = rateplan.MaxCalendarNights
+
+// TaxLine amount a tax adds to the quoted stay
+type TaxLine struct {
+	TaxID  uuid.UUID
+	Name   string
+	Kind   string
+	Amount decimal.Decimal
+}
+
+// Quote price of a stay in a room type, it is computed on demand and never
+// stored
+type Quote struct {
+	HotelID    uuid.UUID
+	RoomTypeID uuid.UUID
+	RatePlanID *uuid.UUID
+	CheckIn    time.Time
+	CheckOut   time.Time
+	Guests     int
+	Currency   string
+	Nights     []rateplan.NightlyRate
+	Subtotal   decimal.Decimal
+	Taxes      []TaxLine
+	TotalTaxes decimal.Decimal
+	Total      decimal.Decimal
+}
+
+// NewQuote Prices every night of the stay under the rate plan, or at the
+// room type's base price when ratePlan is nil, and adds the taxes on top.
+// Nightly prices and tax amounts are rounded to the minor units of the room
+// type's currency before being summed, so the total always matches the
+// breakdown.
+func NewQuote(
+	roomType *roomtype.RoomType,
+	ratePlan *rateplan.RatePlan,
+	checkIn time.Time,
+	checkOut time.Time,
+	guests int,
+	taxes tax.Taxes,
+) *Quote {
+	q := &Quote{
+		HotelID:    roomType.HotelID,
+		RoomTypeID: roomType.ID,
+		CheckIn:    checkIn,
+		CheckOut:   checkOut,
+		Guests:     guests,
+		Currency:   roomType.Currency,
+		Subtotal:   decimal.Zero,
+		TotalTaxes: decimal.Zero,
+	}
+
+	// A plan without prices, seasons nor modifiers sells at the base price
+	pricing := &rateplan.RatePlan{}
+	if ratePlan != nil {
+		pricing = ratePlan
+		q.RatePlanID = &ratePlan.ID
+	}
+
+	q.Nights = pricing.NightlyRates(roomType.ID, roomType.BasePrice, roomType.Currency, checkIn, checkOut)
+	for _, night := range q.Nights {
+		q.Subtotal = q.Subtotal.Add(night.Price)
+	}
+
+	q.Taxes = make([]TaxLine, len(taxes))
+	for i, t := range taxes {
+		amount := t.Charge(q.Subtotal, len(q.Nights), guests, q.Currency)
+		q.Taxes[i] = TaxLine{
+			TaxID:  t.ID,
+			Name:   t.Name,
+			Kind:   t.Kind,
+			Amount: amount,
+		}
+		q.TotalTaxes = q.TotalTaxes.Add(amount)
+	}
+
+	q.Total = q.Subtotal.Add(q.TotalTaxes)
+
+	return q
+}
+
+// Convert Returns the quote with its amounts converted by converter. Every
+// night and tax line is converted from its amount as charged, already rounded
+// in the quote's currency, and rounded again in the target currency. The
+// totals are summed again, so the converted total still matches its
+// breakdown.
+func (q *Quote) Convert(converter currency.Converter) *Quote {
+	converted := *q
+	converted.Currency = converter.To
+	converted.Subtotal = decimal.Zero
+	converted.TotalTaxes = decimal.Zero
+
+	converted.Nights = make([]rateplan.NightlyRate, len(q.Nights))
+	for i, night := range q.Nights {
+		night.Price = converter.Convert(night.Price)
+		converted.Nights[i] = night
+		converted.Subtotal = converted.Subtotal.Add(night.Price)
+	}
+
+	converted.Taxes = make([]TaxLine, len(q.Taxes))
+	for i, line := range q.Taxes {
+		line.Amount = converter.Convert(line.Amount)
+		converted.Taxes[i] = line
+		converted.TotalTaxes = converted.TotalTaxes.Add(line.Amount)
+	}
+
+	converted.Total = converted.Subtotal.Add(converted.TotalTaxes)
+
+	return &converted
+}
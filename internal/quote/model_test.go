@@ -0,0 +1,126 @@
+package quote_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/quote"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/internal/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+var checkIn = time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+// stayTaxes percentage plus per night per guest taxes of a stay
+func stayTaxes(percent string, perNight string) tax.Taxes {
+	return tax.Taxes{
+		{Name: "VAT", Kind: string(tax.PERCENTAGE), Amount: decimal.RequireFromString(percent)},
+		{Name: "City tax", Kind: string(tax.PER_NIGHT), Amount: decimal.RequireFromString(perNight), PerGuest: true},
+	}
+}
+
+func TestNewQuote(t *testing.T) {
+	tests := []struct {
+		name       string
+		currency   string
+		basePrice  string
+		nights     int
+		guests     int
+		taxes      tax.Taxes
+		subtotal   string
+		totalTaxes string
+		total      string
+	}{
+		{name: "without taxes", currency: "USD", basePrice: "99.99", nights: 2, guests: 1,
+			subtotal: "199.98", totalTaxes: "0", total: "199.98"},
+		{name: "two minor units", currency: "USD", basePrice: "99.99", nights: 3, guests: 2,
+			taxes: stayTaxes("10", "2.50"), subtotal: "299.97", totalTaxes: "45.00", total: "344.97"},
+		{name: "no minor units", currency: "JPY", basePrice: "12345", nights: 2, guests: 1,
+			taxes: stayTaxes("8", "200"), subtotal: "24690", totalTaxes: "2375", total: "27065"},
+		{name: "three minor units", currency: "KWD", basePrice: "12.345", nights: 2, guests: 3,
+			taxes: stayTaxes("5", "0.125"), subtotal: "24.690", totalTaxes: "1.985", total: "26.675"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := &roomtype.RoomType{BasePrice: decimal.RequireFromString(tt.basePrice), Currency: tt.currency}
+			q := quote.NewQuote(rt, nil, checkIn, checkIn.AddDate(0, 0, tt.nights), tt.guests, tt.taxes)
+
+			if len(q.Nights) != tt.nights {
+				t.Fatalf("expected %d nights, got %d", tt.nights, len(q.Nights))
+			}
+			expectAmount(t, "subtotal", q.Subtotal, tt.subtotal)
+			expectAmount(t, "taxes", q.TotalTaxes, tt.totalTaxes)
+			expectAmount(t, "total", q.Total, tt.total)
+			expectBalanced(t, q)
+		})
+	}
+}
+
+func TestQuoteConvert(t *testing.T) {
+	tests := []struct {
+		name       string
+		currency   string
+		basePrice  string
+		taxes      tax.Taxes
+		to         string
+		rate       string
+		subtotal   string
+		totalTaxes string
+		total      string
+	}{
+		{name: "into no minor units", currency: "USD", basePrice: "99.99", taxes: stayTaxes("10", "2.50"),
+			to: "JPY", rate: "151.237", subtotal: "45366", totalTaxes: "6806", total: "52172"},
+		{name: "into three minor units", currency: "USD", basePrice: "99.99", taxes: stayTaxes("10", "2.50"),
+			to: "KWD", rate: "0.3075", subtotal: "92.241", totalTaxes: "13.838", total: "106.079"},
+		{name: "from no minor units", currency: "JPY", basePrice: "12345", taxes: stayTaxes("10", "200"),
+			to: "USD", rate: "0.0066", subtotal: "244.44", totalTaxes: "32.37", total: "276.81"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := &roomtype.RoomType{BasePrice: decimal.RequireFromString(tt.basePrice), Currency: tt.currency}
+			q := quote.NewQuote(rt, nil, checkIn, checkIn.AddDate(0, 0, 3), 2, tt.taxes)
+
+			converter := currency.NewConverter(tt.currency, tt.to, decimal.RequireFromString(tt.rate))
+			converted := q.Convert(converter)
+
+			if converted.Currency != tt.to {
+				t.Fatalf("expected currency %s, got %s", tt.to, converted.Currency)
+			}
+			expectAmount(t, "subtotal", converted.Subtotal, tt.subtotal)
+			expectAmount(t, "taxes", converted.TotalTaxes, tt.totalTaxes)
+			expectAmount(t, "total", converted.Total, tt.total)
+			expectBalanced(t, converted)
+		})
+	}
+}
+
+func expectAmount(t *testing.T, name string, got decimal.Decimal, want string) {
+	t.Helper()
+	if !got.Equal(decimal.RequireFromString(want)) {
+		t.Fatalf("expected %s %s, got %s", name, want, got)
+	}
+}
+
+// expectBalanced Fails unless the totals of the quote are the sums of its
+// nights and tax lines
+func expectBalanced(t *testing.T, q *quote.Quote) {
+	t.Helper()
+
+	subtotal := decimal.Zero
+	for _, night := range q.Nights {
+		subtotal = subtotal.Add(night.Price)
+	}
+	totalTaxes := decimal.Zero
+	for _, line := range q.Taxes {
+		totalTaxes = totalTaxes.Add(line.Amount)
+	}
+
+	expectAmount(t, "subtotal", q.Subtotal, subtotal.String())
+	expectAmount(t, "taxes", q.TotalTaxes, totalTaxes.String())
+	expectAmount(t, "total", q.Total, subtotal.Add(totalTaxes).String())
+}
@@ -0,0 +1,102 @@
+package quote
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/hotel"
+	"github.com/sebenitezg/hotel-service/internal/rateplan"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/internal/tax"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type QuoteService struct {
+	hotelService    *hotel.HotelService
+	roomTypeService *roomtype.RoomTypeService
+	ratePlanService *rateplan.RatePlanService
+	taxService      *tax.TaxService
+	currencyService *currency.CurrencyService
+	log             *zap.SugaredLogger
+}
+
+func NewService(
+	hotelService *hotel.HotelService,
+	roomTypeService *roomtype.RoomTypeService,
+	ratePlanService *rateplan.RatePlanService,
+	taxService *tax.TaxService,
+	currencyService *currency.CurrencyService,
+) *QuoteService {
+	return &QuoteService{
+		hotelService:    hotelService,
+		roomTypeService: roomTypeService,
+		ratePlanService: ratePlanService,
+		taxService:      taxService,
+		currencyService: currencyService,
+		log:             logger.GetLogger(),
+	}
+}
+
+// CreateQuote Prices a stay in a room type of the hotel with the taxes of
+// the hotel and its country. Stays without a rate plan are priced at the
+// room type's base price. Quotes are in the currency of the hotel unless
+// currencyCode is given.
+func (s *QuoteService) CreateQuote(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	roomTypeID uuid.UUID,
+	ratePlanID *uuid.UUID,
+	checkIn time.Time,
+	checkOut time.Time,
+	guests int,
+	currencyCode string,
+) (*Quote, error) {
+	if !checkOut.After(checkIn) || checkOut.After(checkIn.AddDate(0, 0, MaxNights)) {
+		return nil, ErrInvalidStayDates
+	}
+
+	h, err := s.hotelService.GetHotelByID(ctx, hotelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	roomType, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false)
+	if err != nil {
+		return nil, err
+	}
+	if guests > roomType.MaxOccupancy {
+		return nil, ErrTooManyGuests
+	}
+
+	var ratePlan *rateplan.RatePlan
+	if ratePlanID != nil {
+		ratePlan, err = s.ratePlanService.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, *ratePlanID)
+		if err != nil {
+			return nil, err
+		}
+		if ratePlan.Status != string(rateplan.ACTIVE) {
+			return nil, ErrRatePlanNotActive
+		}
+	}
+
+	taxes, err := s.taxService.ApplicableTaxes(ctx, h.ID, h.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := NewQuote(roomType, ratePlan, checkIn, checkOut, guests, taxes)
+	if currencyCode == "" {
+		return quote, nil
+	}
+
+	converter, err := s.currencyService.Converter(ctx, quote.Currency, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return quote.Convert(converter), nil
+}
@@ -0,0 +1,188 @@
+package rateplan
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+type CreateRatePlanRequest struct {
+	Name        string `json:"name" validate:"required,max=128"`
+	Description string `json:"description"`
+	Status      string `json:"status" validate:"omitempty,oneof=active inactive"`
+}
+
+type UpdateRatePlanRequest struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=128"`
+	Description *string `json:"description"`
+	Status      *string `json:"status" validate:"omitempty,oneof=active inactive"`
+}
+
+type SetRoomTypePriceRequest struct {
+	Price decimal.Decimal `json:"price"`
+}
+
+type CreateSeasonRequest struct {
+	RoomTypeID uuid.UUID       `json:"room_type_id" validate:"required"`
+	Name       string          `json:"name" validate:"required,max=128"`
+	StartDate  string          `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate    string          `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Price      decimal.Decimal `json:"price"`
+}
+
+type DayOfWeekModifierRequest struct {
+	DayOfWeek string          `json:"day_of_week" validate:"required"`
+	Percent   decimal.Decimal `json:"percent"`
+}
+
+type SetDayOfWeekModifiersRequest struct {
+	Modifiers []DayOfWeekModifierRequest `json:"modifiers" validate:"max=7,dive"`
+}
+
+type RoomTypePriceResponse struct {
+	RoomTypeID uuid.UUID       `json:"room_type_id"`
+	Price      decimal.Decimal `json:"price"`
+}
+
+type SeasonResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	RoomTypeID uuid.UUID       `json:"room_type_id"`
+	Name       string          `json:"name"`
+	StartDate  string          `json:"start_date"`
+	EndDate    string          `json:"end_date"`
+	Price      decimal.Decimal `json:"price"`
+}
+
+type DayOfWeekModifierResponse struct {
+	DayOfWeek string          `json:"day_of_week"`
+	Percent   decimal.Decimal `json:"percent"`
+}
+
+type RatePlanResponse struct {
+	ID          uuid.UUID                   `json:"id"`
+	CreatedAt   string                      `json:"created_at"`
+	UpdatedAt   string                      `json:"updated_at"`
+	HotelID     uuid.UUID                   `json:"hotel_id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	Status      string                      `json:"status"`
+	Prices      []RoomTypePriceResponse     `json:"prices"`
+	Seasons     []SeasonResponse            `json:"seasons"`
+	Modifiers   []DayOfWeekModifierResponse `json:"modifiers"`
+}
+
+type ListRatePlansResponse struct {
+	Results []RatePlanResponse `json:"results"`
+}
+
+type NightlyRateResponse struct {
+	Date  string          `json:"date"`
+	Price decimal.Decimal `json:"price"`
+}
+
+type RatePlanRatesResponse struct {
+	RatePlanID uuid.UUID             `json:"rate_plan_id"`
+	Name       string                `json:"name"`
+	Total      decimal.Decimal       `json:"total"`
+	Nights     []NightlyRateResponse `json:"nights"`
+}
+
+type RoomTypeRatesResponse struct {
+	RoomTypeID uuid.UUID               `json:"room_type_id"`
+	From       string                  `json:"from"`
+	To         string                  `json:"to"`
+	BasePrice  decimal.Decimal         `json:"base_price"`
+	RatePlans  []RatePlanRatesResponse `json:"rate_plans"`
+}
+
+func NewSeasonResponse(s *Season) SeasonResponse {
+	return SeasonResponse{
+		ID:         s.ID,
+		RoomTypeID: s.RoomTypeID,
+		Name:       s.Name,
+		StartDate:  s.StartDate.Format(time.DateOnly),
+		EndDate:    s.EndDate.Format(time.DateOnly),
+		Price:      s.Price,
+	}
+}
+
+func NewRatePlanResponse(rp *RatePlan) RatePlanResponse {
+	prices := make([]RoomTypePriceResponse, len(rp.Prices))
+	for i, price := range rp.Prices {
+		prices[i] = RoomTypePriceResponse{RoomTypeID: price.RoomTypeID, Price: price.Price}
+	}
+	seasons := make([]SeasonResponse, len(rp.Seasons))
+	for i, season := range rp.Seasons {
+		seasons[i] = NewSeasonResponse(&season)
+	}
+	modifiers := make([]DayOfWeekModifierResponse, len(rp.Modifiers))
+	for i, modifier := range rp.Modifiers {
+		modifiers[i] = DayOfWeekModifierResponse{
+			DayOfWeek: strings.ToLower(modifier.DayOfWeek.String()),
+			Percent:   modifier.Percent,
+		}
+	}
+
+	return RatePlanResponse{
+		ID:          rp.ID,
+		CreatedAt:   rp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   rp.UpdatedAt.Format(time.RFC3339),
+		HotelID:     rp.HotelID,
+		Name:        rp.Name,
+		Description: rp.Description,
+		Status:      rp.Status,
+		Prices:      prices,
+		Seasons:     seasons,
+		Modifiers:   modifiers,
+	}
+}
+
+func NewListRatePlansResponse(ratePlans RatePlans) ListRatePlansResponse {
+	responses := make([]RatePlanResponse, len(ratePlans))
+	for i, ratePlan := range ratePlans {
+		responses[i] = NewRatePlanResponse(&ratePlan)
+	}
+	return ListRatePlansResponse{
+		Results: responses,
+	}
+}
+
+func NewRoomTypeRatesResponse(
+	roomType *roomtype.RoomType,
+	from, to time.Time,
+	ratePlans RatePlans,
+) RoomTypeRatesResponse {
+	results := make([]RatePlanRatesResponse, len(ratePlans))
+	for i, ratePlan := range ratePlans {
+		rates := ratePlan.NightlyRates(roomType.ID, roomType.BasePrice, roomType.Currency, from, to)
+
+		total := decimal.Zero
+		nights := make([]NightlyRateResponse, len(rates))
+		for j, rate := range rates {
+			total = total.Add(rate.Price)
+			nights[j] = NightlyRateResponse{
+				Date:  rate.Date.Format(time.DateOnly),
+				Price: rate.Price,
+			}
+		}
+
+		results[i] = RatePlanRatesResponse{
+			RatePlanID: ratePlan.ID,
+			Name:       ratePlan.Name,
+			Total:      total,
+			Nights:     nights,
+		}
+	}
+
+	return RoomTypeRatesResponse{
+		RoomTypeID: roomType.ID,
+		From:       from.Format(time.DateOnly),
+		To:         to.Format(time.DateOnly),
+		BasePrice:  roomType.BasePrice,
+		RatePlans:  results,
+	}
+}
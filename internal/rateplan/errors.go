@@ -0,0 +1,30 @@
+package rateplan
+
+import (
+	"fmt"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound     = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrRatePlanNotFound  = terrors.NotFound("rate_plan", "rate plan not found", nil)
+	ErrSeasonNotFound    = terrors.NotFound("season", "season not found", nil)
+	ErrRoomTypeNotFound  = terrors.NotFound("room_type", "hotel does not have the room type with the provided ID", nil)
+	ErrInvalidPrice      = terrors.BadRequest("price", "price must be a non negative decimal number", nil)
+	ErrInvalidPercent    = terrors.BadRequest("percent", "percent must be greater than -100", nil)
+	ErrInvalidSeasonDate = terrors.BadRequest("season_dates", "end_date must not be before start_date", nil)
+	ErrDuplicateWeekday  = terrors.BadRequest("day_of_week", "each day of the week can only be modified once", nil)
+	ErrInvalidRateRange  = terrors.BadRequest(
+		"rate_dates",
+		fmt.Sprintf("from and to must be dates with to after from and at most %d nights apart", MaxCalendarNights),
+		nil,
+	)
+	ErrInvalidRatePlanFilter = terrors.BadRequest("rate_plan_id", "rate_plan_id must be a valid identifier", nil)
+	ErrRatePlanNameTaken     = core.Conflict("rate_plan_name_taken", "the hotel already has a rate plan with this name", nil)
+	ErrSeasonOverlaps        = core.Conflict(
+		"season_overlaps", "the rate plan already has a season for this room type on some of the dates", nil,
+	)
+)
@@ -0,0 +1,420 @@
+package rateplan
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type RatePlanController struct {
+	validator       *validator.Validate
+	ratePlanService *RatePlanService
+	log             *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	ratePlanService *RatePlanService,
+	membershipChecker auth.HotelMembershipChecker,
+) *RatePlanController {
+	c := &RatePlanController{
+		validator:       validator,
+		ratePlanService: ratePlanService,
+		log:             logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/rateplans", c.handleListHotelRatePlans)
+		r.Get("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}", c.handleGetHotelRatePlan)
+		r.Get("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}/rates", c.handleGetRoomTypeRates)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Post("/v1/hotels/{hotel_id}/rateplans", c.handleCreateHotelRatePlan)
+		r.Patch("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}", c.handlePartialUpdateHotelRatePlan)
+		r.Delete("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}", c.handleDeleteHotelRatePlan)
+		r.Put("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}/prices/{room_type_id}", c.handleSetRoomTypePrice)
+		r.Post("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}/seasons", c.handleAddSeason)
+		r.Delete("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}/seasons/{season_id}", c.handleRemoveSeason)
+		r.Put("/v1/hotels/{hotel_id}/rateplans/{rate_plan_id}/modifiers", c.handleSetDayOfWeekModifiers)
+	})
+
+	return c
+}
+
+func (c *RatePlanController) handleListHotelRatePlans(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	ratePlans, err := c.ratePlanService.ListRatePlansByHotelID(r.Context(), uuidHotelID, r.URL.Query().Get("status"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListRatePlansResponse(ratePlans)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) handleGetHotelRatePlan(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	ratePlan, err := c.ratePlanService.RetrieveRatePlanByHotelRatePlanID(r.Context(), uuidHotelID, uuidRatePlanID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) handleGetRoomTypeRates(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, roomtype.ErrRoomTypeNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+
+	from, err := time.Parse(time.DateOnly, query.Get("from"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidRateRange)
+		return
+	}
+	to, err := time.Parse(time.DateOnly, query.Get("to"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, ErrInvalidRateRange)
+		return
+	}
+
+	var ratePlanID *uuid.UUID
+	if rawRatePlanID := query.Get("rate_plan_id"); rawRatePlanID != "" {
+		id, err := uuid.FromString(rawRatePlanID)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidRatePlanFilter)
+			return
+		}
+		ratePlanID = &id
+	}
+
+	roomType, ratePlans, err := c.ratePlanService.RoomTypeRates(
+		r.Context(), uuidHotelID, uuidRoomTypeID, from, to, ratePlanID,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRoomTypeRatesResponse(roomType, from, to, ratePlans)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) handleCreateHotelRatePlan(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CreateRatePlanRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	ratePlan, err := NewRatePlan(uuidHotelID, payload.Name, payload.Description, payload.Status)
+	if err != nil {
+		c.log.Errorw("failure creating rate plan instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	ratePlan, err = c.ratePlanService.CreateRatePlan(r.Context(), core.ActorFromContext(r.Context()), ratePlan)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *RatePlanController) handlePartialUpdateHotelRatePlan(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload UpdateRatePlanRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	ratePlan, err := c.ratePlanService.UpdatePartiallyRatePlan(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
+		uuidHotelID,
+		uuidRatePlanID,
+		payload.Name,
+		payload.Description,
+		payload.Status,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) handleDeleteHotelRatePlan(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	err := c.ratePlanService.DeleteRatePlan(
+		r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRatePlanID,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *RatePlanController) handleSetRoomTypePrice(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomTypeNotFound)
+		return
+	}
+
+	var payload SetRoomTypePriceRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	ratePlan, err := c.ratePlanService.SetRoomTypePrice(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
+		uuidHotelID,
+		uuidRatePlanID,
+		uuidRoomTypeID,
+		payload.Price,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) handleAddSeason(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CreateSeasonRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	// Dates were already validated against the layout by decode
+	startDate, _ := time.Parse(time.DateOnly, payload.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, payload.EndDate)
+
+	season, err := NewSeason(uuidRatePlanID, payload.RoomTypeID, payload.Name, startDate, endDate, payload.Price)
+	if err != nil {
+		c.log.Errorw("failure creating season instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	ratePlan, err := c.ratePlanService.AddSeason(r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, season)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *RatePlanController) handleRemoveSeason(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	seasonID := chi.URLParam(r, "season_id")
+	uuidSeasonID, err := uuid.FromString(seasonID)
+	if err != nil {
+		c.log.Errorw("invalid season id", "seasonID", seasonID, "error", err)
+		rest.RenderError(r.Context(), w, ErrSeasonNotFound)
+		return
+	}
+
+	_, err = c.ratePlanService.RemoveSeason(
+		r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRatePlanID, uuidSeasonID,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *RatePlanController) handleSetDayOfWeekModifiers(w http.ResponseWriter, r *http.Request) {
+	uuidHotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+	uuidRatePlanID, ok := c.ratePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload SetDayOfWeekModifiersRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	modifiers := make([]DayOfWeekModifier, len(payload.Modifiers))
+	for i, modifier := range payload.Modifiers {
+		day, ok := ParseWeekday(modifier.DayOfWeek)
+		if !ok {
+			rest.RenderError(
+				r.Context(), w, terrors.BadRequest("day_of_week", "day_of_week must be a day name such as monday", nil),
+			)
+			return
+		}
+		modifiers[i] = DayOfWeekModifier{DayOfWeek: day, Percent: modifier.Percent}
+	}
+
+	ratePlan, err := c.ratePlanService.SetDayOfWeekModifiers(
+		r.Context(),
+		core.ActorFromContext(r.Context()), uuidHotelID, uuidRatePlanID, modifiers,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRatePlanResponse(ratePlan)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RatePlanController) hotelID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return uuid.Nil, false
+	}
+	return uuidHotelID, true
+}
+
+func (c *RatePlanController) ratePlanID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ratePlanID := chi.URLParam(r, "rate_plan_id")
+	uuidRatePlanID, err := uuid.FromString(ratePlanID)
+	if err != nil {
+		c.log.Errorw("invalid rate plan id", "ratePlanID", ratePlanID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRatePlanNotFound)
+		return uuid.Nil, false
+	}
+	return uuidRatePlanID, true
+}
+
+// decode Reads and validates the request body into payload, rendering the
+// error when it fails
+func (c *RatePlanController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package rateplan
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type Status string
+
+const (
+	ACTIVE   Status = "active"
+	INACTIVE Status = "inactive"
+)
+
+// MaxCalendarNights longest range of nights a rate calendar can span
+const MaxCalendarNights = 366
+
+var hundred = decimal.NewFromInt(100)
+
+// --------------------
+// DB models
+// --------------------
+type RatePlan struct {
+	bun.BaseModel `bun:"table:rate_plans"`
+	ID            uuid.UUID           `bun:"id,pk"`
+	CreatedAt     time.Time           `bun:"created_at"`
+	UpdatedAt     time.Time           `bun:"updated_at"`
+	HotelID       uuid.UUID           `bun:"hotel_id"`
+	Name          string              `bun:"name"`
+	Description   string              `bun:"description"`
+	Status        string              `bun:"status"`
+	Prices        []RoomTypePrice     `bun:"rel:has-many,join:id=rate_plan_id"`
+	Seasons       []Season            `bun:"rel:has-many,join:id=rate_plan_id"`
+	Modifiers     []DayOfWeekModifier `bun:"rel:has-many,join:id=rate_plan_id"`
+}
+
+type RatePlans []RatePlan
+
+// RoomTypePrice nightly price of a room type under a rate plan
+type RoomTypePrice struct {
+	bun.BaseModel `bun:"table:rate_plan_prices"`
+	RatePlanID    uuid.UUID       `bun:"rate_plan_id,pk"`
+	RoomTypeID    uuid.UUID       `bun:"room_type_id,pk"`
+	Price         decimal.Decimal `bun:"price"`
+}
+
+// Season overrides the nightly price of a room type between StartDate and
+// EndDate, both included
+type Season struct {
+	bun.BaseModel `bun:"table:rate_plan_seasons"`
+	ID            uuid.UUID       `bun:"id,pk"`
+	CreatedAt     time.Time       `bun:"created_at"`
+	RatePlanID    uuid.UUID       `bun:"rate_plan_id"`
+	RoomTypeID    uuid.UUID       `bun:"room_type_id"`
+	Name          string          `bun:"name"`
+	StartDate     time.Time       `bun:"start_date,type:date"`
+	EndDate       time.Time       `bun:"end_date,type:date"`
+	Price         decimal.Decimal `bun:"price"`
+}
+
+// DayOfWeekModifier percentage added to the nightly price on a day of the
+// week, negative percentages are discounts
+type DayOfWeekModifier struct {
+	bun.BaseModel `bun:"table:rate_plan_day_modifiers"`
+	RatePlanID    uuid.UUID       `bun:"rate_plan_id,pk"`
+	DayOfWeek     time.Weekday    `bun:"day_of_week,pk"`
+	Percent       decimal.Decimal `bun:"percent"`
+}
+
+// NightlyRate price of the night starting on Date
+type NightlyRate struct {
+	Date  time.Time
+	Price decimal.Decimal
+}
+
+func NewRatePlan(
+	hotelID uuid.UUID,
+	name string,
+	description string,
+	status string,
+) (*RatePlan, error) {
+	now := time.Now().UTC()
+
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	if status == "" {
+		status = string(ACTIVE)
+	}
+
+	return &RatePlan{
+		ID:          id,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		HotelID:     hotelID,
+		Name:        name,
+		Description: description,
+		Status:      status,
+	}, nil
+}
+
+func NewSeason(
+	ratePlanID uuid.UUID,
+	roomTypeID uuid.UUID,
+	name string,
+	startDate time.Time,
+	endDate time.Time,
+	price decimal.Decimal,
+) (*Season, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Season{
+		ID:         id,
+		CreatedAt:  time.Now().UTC(),
+		RatePlanID: ratePlanID,
+		RoomTypeID: roomTypeID,
+		Name:       name,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Price:      price,
+	}, nil
+}
+
+// ParseWeekday Returns the day of the week of its English name
+func ParseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Season) covers(date time.Time) bool {
+	return !date.Before(s.StartDate) && !date.After(s.EndDate)
+}
+
+// NightlyPrice Price of the night starting on date for the room type. The
+// season covering the night takes precedence over the plan's room type
+// price, which in turn replaces the room type's base price. The day of the
+// week modifier is applied last, and the price is rounded to the minor units
+// of the room type's currency.
+func (p *RatePlan) NightlyPrice(
+	roomTypeID uuid.UUID, basePrice decimal.Decimal, currencyCode string, date time.Time,
+) decimal.Decimal {
+	price := basePrice
+	for _, rtp := range p.Prices {
+		if rtp.RoomTypeID == roomTypeID {
+			price = rtp.Price
+			break
+		}
+	}
+	for _, season := range p.Seasons {
+		if season.RoomTypeID == roomTypeID && season.covers(date) {
+			price = season.Price
+			break
+		}
+	}
+	for _, modifier := range p.Modifiers {
+		if modifier.DayOfWeek == date.Weekday() {
+			price = price.Mul(hundred.Add(modifier.Percent)).Div(hundred)
+			break
+		}
+	}
+	return currency.Round(price, currencyCode)
+}
+
+// NightlyRates Price calendar of the room type for every night from from
+// until the night before to
+func (p *RatePlan) NightlyRates(
+	roomTypeID uuid.UUID, basePrice decimal.Decimal, currencyCode string, from, to time.Time,
+) []NightlyRate {
+	var rates []NightlyRate
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		rates = append(rates, NightlyRate{
+			Date:  date,
+			Price: p.NightlyPrice(roomTypeID, basePrice, currencyCode, date),
+		})
+	}
+	return rates
+}
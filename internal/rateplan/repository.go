@@ -0,0 +1,202 @@
+package rateplan
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sebenitezg/hotel-service/internal/audit"
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// uniqueViolation is the Postgres error code raised when the hotel
+	// already has a rate plan with the same name.
+	uniqueViolation = "23505"
+	// exclusionViolation is the Postgres error code raised when two seasons
+	// of a room type overlap.
+	exclusionViolation = "23P01"
+)
+
+type RatePlanRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *RatePlanRepository {
+	return &RatePlanRepository{
+		db: db,
+	}
+}
+
+// Save Creates the rate plan and stores its audit record and the events in
+// the outbox within the same transaction
+func (r *RatePlanRepository) Save(
+	ctx context.Context, ratePlan *RatePlan, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(ratePlan).Exec(ctx)
+		return err
+	}, record, events)
+}
+
+// Update Saves the rate plan attributes and stores their audit record and the
+// events in the outbox within the same transaction
+func (r *RatePlanRepository) Update(
+	ctx context.Context, ratePlan *RatePlan, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model(ratePlan).
+			Column("updated_at", "name", "description", "status").
+			Where("id = ?", ratePlan.ID).
+			Exec(ctx)
+		return err
+	}, record, events)
+}
+
+// Delete Removes the rate plan along with its prices, seasons and modifiers
+func (r *RatePlanRepository) Delete(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*RatePlan)(nil)).Where("id = ?", id).Exec(ctx)
+		return err
+	}, record, events)
+}
+
+// SavePrice Creates or replaces the price of a room type under the plan
+func (r *RatePlanRepository) SavePrice(
+	ctx context.Context, price *RoomTypePrice, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(price).
+			On("CONFLICT (rate_plan_id, room_type_id) DO UPDATE").
+			Set("price = EXCLUDED.price").
+			Exec(ctx)
+		return err
+	}, record, events)
+}
+
+func (r *RatePlanRepository) SaveSeason(
+	ctx context.Context, season *Season, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(season).Exec(ctx)
+		return err
+	}, record, events)
+}
+
+func (r *RatePlanRepository) DeleteSeason(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*Season)(nil)).Where("id = ?", id).Exec(ctx)
+		return err
+	}, record, events)
+}
+
+// ReplaceModifiers Replaces every day of the week modifier of the plan
+func (r *RatePlanRepository) ReplaceModifiers(
+	ctx context.Context,
+	ratePlanID uuid.UUID,
+	modifiers []DayOfWeekModifier,
+	record core.AuditRecord,
+	events ...core.DomainEvent,
+) error {
+	return r.inTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*DayOfWeekModifier)(nil)).
+			Where("rate_plan_id = ?", ratePlanID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if len(modifiers) == 0 {
+			return nil
+		}
+		_, err = tx.NewInsert().Model(&modifiers).Exec(ctx)
+		return err
+	}, record, events)
+}
+
+// GetByID Returns the rate plan with its prices, seasons and modifiers
+func (r *RatePlanRepository) GetByID(ctx context.Context, id uuid.UUID) (*RatePlan, error) {
+	var ratePlan RatePlan
+	err := r.selectRatePlans(&ratePlan).
+		Where("rate_plan.id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ratePlan, nil
+}
+
+// GetByHotelID Returns the hotel's rate plans, only the ones in the given
+// status when it is not empty
+func (r *RatePlanRepository) GetByHotelID(ctx context.Context, hotelID uuid.UUID, status string) (RatePlans, error) {
+	var ratePlans RatePlans
+	q := r.selectRatePlans(&ratePlans).
+		Where("rate_plan.hotel_id = ?", hotelID).
+		Order("rate_plan.name ASC")
+
+	if status != "" {
+		q = q.Where("rate_plan.status = ?", status)
+	}
+
+	err := q.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ratePlans, nil
+}
+
+func (r *RatePlanRepository) selectRatePlans(model any) *bun.SelectQuery {
+	return r.db.NewSelect().
+		Model(model).
+		Relation("Prices").
+		Relation("Seasons", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("start_date ASC")
+		}).
+		Relation("Modifiers", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("day_of_week ASC")
+		})
+}
+
+// inTx Runs write, stores its audit record and the events in the outbox
+// within one transaction, translating the constraint violations
+func (r *RatePlanRepository) inTx(
+	ctx context.Context,
+	write func(ctx context.Context, tx bun.Tx) error,
+	record core.AuditRecord,
+	events []core.DomainEvent,
+) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := write(ctx, tx); err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return ErrRatePlanNameTaken
+		case exclusionViolation:
+			return ErrSeasonOverlaps
+		}
+	}
+	return err
+}
@@ -0,0 +1,362 @@
+package rateplan
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// entityType entity type of the rate plans in the audit log and domain
+// events
+const entityType = "rate_plan"
+
+type RatePlanService struct {
+	ratePlanRepo    *RatePlanRepository
+	hotelValidator  core.HotelValidator
+	roomTypeService *roomtype.RoomTypeService
+	log             *zap.SugaredLogger
+}
+
+func NewService(
+	ratePlanRepo *RatePlanRepository,
+	hotelValidator core.HotelValidator,
+	roomTypeService *roomtype.RoomTypeService,
+) *RatePlanService {
+	return &RatePlanService{
+		ratePlanRepo:    ratePlanRepo,
+		hotelValidator:  hotelValidator,
+		roomTypeService: roomTypeService,
+		log:             logger.GetLogger(),
+	}
+}
+
+func (s *RatePlanService) ListRatePlansByHotelID(
+	ctx context.Context, hotelID uuid.UUID, status string,
+) (RatePlans, error) {
+	ratePlans, err := s.ratePlanRepo.GetByHotelID(ctx, hotelID, status)
+	if err != nil {
+		s.log.Errorw("error retrieving rate plans by hotel ID", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return ratePlans, nil
+}
+
+func (s *RatePlanService) RetrieveRatePlanByHotelRatePlanID(
+	ctx context.Context, hotelID uuid.UUID, ratePlanID uuid.UUID,
+) (*RatePlan, error) {
+	ratePlan, err := s.ratePlanRepo.GetByID(ctx, ratePlanID)
+	if err != nil {
+		s.log.Errorw("error retrieving rate plan", "ratePlanID", ratePlanID, "error", err)
+		return nil, err
+	}
+
+	if ratePlan == nil || ratePlan.HotelID != hotelID {
+		s.log.Errorw(
+			"hotel does not have the rate plan with the provided ID",
+			"hotelID", hotelID, "ratePlanID", ratePlanID,
+		)
+		return nil, ErrRatePlanNotFound
+	}
+
+	return ratePlan, nil
+}
+
+func (s *RatePlanService) CreateRatePlan(ctx context.Context, actor core.Actor, ratePlan *RatePlan) (*RatePlan, error) {
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, ratePlan.HotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", ratePlan.HotelID, "error", err)
+		return nil, err
+	}
+	if !hotelExist {
+		return nil, ErrHotelNotFound
+	}
+
+	record := newAuditRecord(actor, core.ActionCreate, ratePlan, nil, NewRatePlanResponse(ratePlan))
+	if err := s.ratePlanRepo.Save(ctx, ratePlan, record, newEvent(core.RatePlanCreated, ratePlan)); err != nil {
+		s.log.Errorw("error creating rate plan", "hotelID", ratePlan.HotelID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("rate plan created successfully", "hotelID", ratePlan.HotelID, "ratePlanID", ratePlan.ID)
+
+	return ratePlan, nil
+}
+
+func (s *RatePlanService) UpdatePartiallyRatePlan(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	ratePlanID uuid.UUID,
+	name *string,
+	description *string,
+	status *string,
+) (*RatePlan, error) {
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := NewRatePlanResponse(ratePlan)
+
+	if name != nil {
+		ratePlan.Name = *name
+	}
+	if description != nil {
+		ratePlan.Description = *description
+	}
+	if status != nil {
+		ratePlan.Status = *status
+	}
+	ratePlan.UpdatedAt = time.Now().UTC()
+
+	return s.saveChange(actor, before, ratePlan, func(record core.AuditRecord, event core.DomainEvent) error {
+		return s.ratePlanRepo.Update(ctx, ratePlan, record, event)
+	})
+}
+
+// DeleteRatePlan Removes the rate plan along with its prices, seasons and
+// modifiers
+func (s *RatePlanService) DeleteRatePlan(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, ratePlanID uuid.UUID,
+) error {
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, ratePlanID)
+	if err != nil {
+		return err
+	}
+
+	record := newAuditRecord(actor, core.ActionDelete, ratePlan, NewRatePlanResponse(ratePlan), nil)
+	if err := s.ratePlanRepo.Delete(ctx, ratePlan.ID, record, newEvent(core.RatePlanDeleted, ratePlan)); err != nil {
+		s.log.Errorw("failure deleting rate plan", "ratePlanID", ratePlanID, "error", err)
+		return err
+	}
+
+	s.log.Infow("rate plan deleted successfully", "hotelID", hotelID, "ratePlanID", ratePlanID)
+
+	return nil
+}
+
+// SetRoomTypePrice Sets the nightly price of a hotel's room type under the
+// rate plan
+func (s *RatePlanService) SetRoomTypePrice(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	ratePlanID uuid.UUID,
+	roomTypeID uuid.UUID,
+	price decimal.Decimal,
+) (*RatePlan, error) {
+	if price.IsNegative() {
+		return nil, ErrInvalidPrice
+	}
+
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateHotelRoomType(ctx, hotelID, roomTypeID); err != nil {
+		return nil, err
+	}
+
+	before := NewRatePlanResponse(ratePlan)
+
+	roomTypePrice := RoomTypePrice{RatePlanID: ratePlan.ID, RoomTypeID: roomTypeID, Price: price}
+	replaced := false
+	for i := range ratePlan.Prices {
+		if ratePlan.Prices[i].RoomTypeID == roomTypeID {
+			ratePlan.Prices[i] = roomTypePrice
+			replaced = true
+		}
+	}
+	if !replaced {
+		ratePlan.Prices = append(ratePlan.Prices, roomTypePrice)
+	}
+
+	return s.saveChange(actor, before, ratePlan, func(record core.AuditRecord, event core.DomainEvent) error {
+		return s.ratePlanRepo.SavePrice(ctx, &roomTypePrice, record, event)
+	})
+}
+
+// AddSeason Adds a seasonal price override to the rate plan, seasons of the
+// same room type cannot overlap
+func (s *RatePlanService) AddSeason(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, season *Season,
+) (*RatePlan, error) {
+	if season.EndDate.Before(season.StartDate) {
+		return nil, ErrInvalidSeasonDate
+	}
+	if season.Price.IsNegative() {
+		return nil, ErrInvalidPrice
+	}
+
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, season.RatePlanID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateHotelRoomType(ctx, hotelID, season.RoomTypeID); err != nil {
+		return nil, err
+	}
+
+	before := NewRatePlanResponse(ratePlan)
+	ratePlan.Seasons = append(ratePlan.Seasons, *season)
+
+	return s.saveChange(actor, before, ratePlan, func(record core.AuditRecord, event core.DomainEvent) error {
+		return s.ratePlanRepo.SaveSeason(ctx, season, record, event)
+	})
+}
+
+func (s *RatePlanService) RemoveSeason(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, ratePlanID uuid.UUID, seasonID uuid.UUID,
+) (*RatePlan, error) {
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := NewRatePlanResponse(ratePlan)
+
+	seasons := make([]Season, 0, len(ratePlan.Seasons))
+	for _, season := range ratePlan.Seasons {
+		if season.ID != seasonID {
+			seasons = append(seasons, season)
+		}
+	}
+	if len(seasons) == len(ratePlan.Seasons) {
+		return nil, ErrSeasonNotFound
+	}
+	ratePlan.Seasons = seasons
+
+	return s.saveChange(actor, before, ratePlan, func(record core.AuditRecord, event core.DomainEvent) error {
+		return s.ratePlanRepo.DeleteSeason(ctx, seasonID, record, event)
+	})
+}
+
+// SetDayOfWeekModifiers Replaces the day of the week modifiers of the rate
+// plan, days without a modifier keep the nightly price unchanged
+func (s *RatePlanService) SetDayOfWeekModifiers(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, ratePlanID uuid.UUID, modifiers []DayOfWeekModifier,
+) (*RatePlan, error) {
+	seen := make(map[time.Weekday]bool, len(modifiers))
+	for i := range modifiers {
+		if modifiers[i].Percent.LessThanOrEqual(hundred.Neg()) {
+			return nil, ErrInvalidPercent
+		}
+		if seen[modifiers[i].DayOfWeek] {
+			return nil, ErrDuplicateWeekday
+		}
+		seen[modifiers[i].DayOfWeek] = true
+		modifiers[i].RatePlanID = ratePlanID
+	}
+
+	ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(modifiers, func(a, b DayOfWeekModifier) int {
+		return int(a.DayOfWeek) - int(b.DayOfWeek)
+	})
+
+	before := NewRatePlanResponse(ratePlan)
+	ratePlan.Modifiers = modifiers
+
+	return s.saveChange(actor, before, ratePlan, func(record core.AuditRecord, event core.DomainEvent) error {
+		return s.ratePlanRepo.ReplaceModifiers(ctx, ratePlanID, modifiers, record, event)
+	})
+}
+
+// RoomTypeRates Returns the hotel's room type and the rate plans pricing it
+// between from and to. Every active plan is returned unless ratePlanID is
+// given.
+func (s *RatePlanService) RoomTypeRates(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	roomTypeID uuid.UUID,
+	from time.Time,
+	to time.Time,
+	ratePlanID *uuid.UUID,
+) (*roomtype.RoomType, RatePlans, error) {
+	if !to.After(from) || to.After(from.AddDate(0, 0, MaxCalendarNights)) {
+		return nil, nil, ErrInvalidRateRange
+	}
+
+	roomType, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ratePlanID != nil {
+		ratePlan, err := s.RetrieveRatePlanByHotelRatePlanID(ctx, hotelID, *ratePlanID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return roomType, RatePlans{*ratePlan}, nil
+	}
+
+	ratePlans, err := s.ListRatePlansByHotelID(ctx, hotelID, string(ACTIVE))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roomType, ratePlans, nil
+}
+
+func (s *RatePlanService) validateHotelRoomType(ctx context.Context, hotelID uuid.UUID, roomTypeID uuid.UUID) error {
+	_, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false)
+	if err == roomtype.ErrRoomTypeNotFound {
+		return ErrRoomTypeNotFound
+	}
+	return err
+}
+
+// saveChange Persists a change of the rate plan through save, which receives
+// the audit record and the RatePlanUpdated event to store along with it
+func (s *RatePlanService) saveChange(
+	actor core.Actor,
+	before RatePlanResponse,
+	ratePlan *RatePlan,
+	save func(record core.AuditRecord, event core.DomainEvent) error,
+) (*RatePlan, error) {
+	record := newAuditRecord(actor, core.ActionUpdate, ratePlan, before, NewRatePlanResponse(ratePlan))
+	if err := save(record, newEvent(core.RatePlanUpdated, ratePlan)); err != nil {
+		s.log.Errorw("failure updating rate plan", "ratePlanID", ratePlan.ID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("rate plan updated successfully", "hotelID", ratePlan.HotelID, "ratePlanID", ratePlan.ID)
+
+	return ratePlan, nil
+}
+
+// newAuditRecord Audit record of a change made to the rate plan by the actor
+func newAuditRecord(actor core.Actor, action string, ratePlan *RatePlan, before, after any) core.AuditRecord {
+	return core.AuditRecord{
+		Actor: actor,
+		Change: core.Change{
+			HotelID:    ratePlan.HotelID,
+			EntityType: entityType,
+			EntityID:   ratePlan.ID,
+			Action:     action,
+			Before:     before,
+			After:      after,
+		},
+	}
+}
+
+// newEvent Domain event of a change made to the rate plan
+func newEvent(eventType string, ratePlan *RatePlan) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          eventType,
+		HotelID:       ratePlan.HotelID,
+		AggregateType: entityType,
+		AggregateID:   ratePlan.ID,
+		Payload:       NewRatePlanResponse(ratePlan),
+	}
+}
@@ -0,0 +1,66 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateReservationRequest books the room for the guest profile of guest_id,
+// or for the hotel's guest with guest_email, whose profile is created when
+// there is none
+type CreateReservationRequest struct {
+	RoomID     uuid.UUID  `json:"room_id" validate:"required"`
+	GuestID    *uuid.UUID `json:"guest_id"`
+	GuestName  string     `json:"guest_name" validate:"required_without=GuestID,max=128"`
+	GuestEmail string     `json:"guest_email" validate:"required_without=GuestID,omitempty,email,max=256"`
+	Guests     int        `json:"guests" validate:"required,min=1"`
+	CheckIn    string     `json:"check_in" validate:"required,datetime=2006-01-02"`
+	CheckOut   string     `json:"check_out" validate:"required,datetime=2006-01-02"`
+}
+
+type ReservationResponse struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  string    `json:"created_at"`
+	UpdatedAt  string    `json:"updated_at"`
+	HotelID    uuid.UUID `json:"hotel_id"`
+	RoomID     uuid.UUID `json:"room_id"`
+	GuestID    uuid.UUID `json:"guest_id"`
+	GuestName  string    `json:"guest_name"`
+	GuestEmail string    `json:"guest_email"`
+	Guests     int       `json:"guests"`
+	CheckIn    string    `json:"check_in"`
+	CheckOut   string    `json:"check_out"`
+	Status     string    `json:"status"`
+}
+
+type ListReservationsResponse struct {
+	Results []ReservationResponse `json:"results"`
+}
+
+func NewReservationResponse(r *Reservation) ReservationResponse {
+	return ReservationResponse{
+		ID:         r.ID,
+		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  r.UpdatedAt.Format(time.RFC3339),
+		HotelID:    r.HotelID,
+		RoomID:     r.RoomID,
+		GuestID:    r.GuestID,
+		GuestName:  r.GuestName,
+		GuestEmail: r.GuestEmail,
+		Guests:     r.Guests,
+		CheckIn:    r.CheckIn.Format(time.DateOnly),
+		CheckOut:   r.CheckOut.Format(time.DateOnly),
+		Status:     r.Status,
+	}
+}
+
+func NewListReservationsResponse(reservations Reservations) ListReservationsResponse {
+	responses := make([]ReservationResponse, len(reservations))
+	for i, reservation := range reservations {
+		responses[i] = NewReservationResponse(&reservation)
+	}
+	return ListReservationsResponse{
+		Results: responses,
+	}
+}
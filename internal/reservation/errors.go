@@ -0,0 +1,32 @@
+package reservation
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrReservationNotFound = terrors.NotFound("reservation", "reservation not found", nil)
+	ErrInvalidStayDates    = terrors.BadRequest("stay_dates", "check_out must be after check_in", nil)
+	ErrRoomNotFound        = terrors.NotFound("room", "hotel does not have the room with the provided ID", nil)
+	ErrGuestNotFound       = terrors.NotFound("guest", "hotel does not have the guest with the provided ID", nil)
+	ErrRoomAlreadyBooked   = core.Conflict(
+		"room_already_booked", "room is already booked for some of the requested nights", nil,
+	)
+	ErrRoomOutOfOrder = terrors.PreconditionFailed(
+		"room_out_of_order", "room is out of order for some of the requested nights", nil,
+	)
+)
+
+// newPartyTooLargeError Error of a party larger than the room's occupancy
+func newPartyTooLargeError(guests int, maxOccupancy int) error {
+	return terrors.BadRequest(
+		"guests",
+		fmt.Sprintf("the room hosts at most %d guests, the party has %d", maxOccupancy, guests),
+		map[string]string{"max_occupancy": strconv.Itoa(maxOccupancy)},
+	)
+}
@@ -0,0 +1,187 @@
+package reservation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type ReservationController struct {
+	validator          *validator.Validate
+	reservationService *ReservationService
+	log                *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	reservationService *ReservationService,
+	membershipChecker auth.HotelMembershipChecker,
+) *ReservationController {
+	c := &ReservationController{
+		validator:          validator,
+		reservationService: reservationService,
+		log:                logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleReservationRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/reservations", c.handleListHotelReservations)
+		r.Get("/v1/hotels/{hotel_id}/reservations/{reservation_id}", c.handleGetHotelReservation)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleReservationWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Post("/v1/hotels/{hotel_id}/reservations", c.handleCreateHotelReservation)
+		r.Delete("/v1/hotels/{hotel_id}/reservations/{reservation_id}", c.handleCancelHotelReservation)
+	})
+
+	return c
+}
+
+func (c *ReservationController) handleListHotelReservations(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	reservations, err := c.reservationService.ListReservationsByHotelID(r.Context(), uuidHotelID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListReservationsResponse(reservations)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *ReservationController) handleGetHotelReservation(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	reservationID := chi.URLParam(r, "reservation_id")
+	uuidReservationID, err := uuid.FromString(reservationID)
+	if err != nil {
+		c.log.Errorw("invalid reservation id", "reservationID", reservationID, "error", err)
+		rest.RenderError(r.Context(), w, ErrReservationNotFound)
+		return
+	}
+
+	reservation, err := c.reservationService.RetrieveReservationByHotelReservationID(
+		r.Context(), uuidHotelID, uuidReservationID,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewReservationResponse(reservation)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *ReservationController) handleCreateHotelReservation(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	var payload CreateReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	// Dates were already validated against the layout above
+	checkIn, _ := time.Parse(time.DateOnly, payload.CheckIn)
+	checkOut, _ := time.Parse(time.DateOnly, payload.CheckOut)
+
+	var guestID uuid.UUID
+	if payload.GuestID != nil {
+		guestID = *payload.GuestID
+	}
+
+	reservation, err := NewReservation(
+		uuidHotelID,
+		payload.RoomID,
+		guestID,
+		payload.GuestName,
+		payload.GuestEmail,
+		payload.Guests,
+		checkIn,
+		checkOut,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating reservation instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	reservation, err = c.reservationService.CreateReservation(r.Context(), reservation)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewReservationResponse(reservation)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *ReservationController) handleCancelHotelReservation(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	reservationID := chi.URLParam(r, "reservation_id")
+	uuidReservationID, err := uuid.FromString(reservationID)
+	if err != nil {
+		c.log.Errorw("invalid reservation id", "reservationID", reservationID, "error", err)
+		rest.RenderError(r.Context(), w, ErrReservationNotFound)
+		return
+	}
+
+	if err := c.reservationService.CancelReservation(r.Context(), uuidHotelID, uuidReservationID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
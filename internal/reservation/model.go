@@ -0,0 +1,71 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type Status string
+
+const (
+	CONFIRMED Status = "confirmed"
+	CANCELLED Status = "cancelled"
+)
+
+// --------------------
+// DB models
+// --------------------
+type Reservation struct {
+	bun.BaseModel `bun:"table:reservations"`
+	ID            uuid.UUID `bun:"id"`
+	CreatedAt     time.Time `bun:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	RoomID        uuid.UUID `bun:"room_id"`
+	GuestID       uuid.UUID `bun:"guest_id,nullzero"`
+	Guests        int       `bun:"guests"`
+	CheckIn       time.Time `bun:"check_in,type:date"`
+	CheckOut      time.Time `bun:"check_out,type:date"`
+	Status        string    `bun:"status"`
+	// GuestName and GuestEmail are read from the guest profile, they are not
+	// stored
+	GuestName  string `bun:"-"`
+	GuestEmail string `bun:"-"`
+}
+
+type Reservations []Reservation
+
+func NewReservation(
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	guestID uuid.UUID,
+	guestName string,
+	guestEmail string,
+	guests int,
+	checkIn time.Time,
+	checkOut time.Time,
+) (*Reservation, error) {
+	now := time.Now().UTC()
+
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reservation{
+		ID:         id,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		HotelID:    hotelID,
+		RoomID:     roomID,
+		GuestID:    guestID,
+		GuestName:  guestName,
+		GuestEmail: guestEmail,
+		Guests:     guests,
+		CheckIn:    checkIn,
+		CheckOut:   checkOut,
+		Status:     string(CONFIRMED),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/inventory"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+// exclusionViolation is the Postgres error code raised by the
+// reservations_room_stay_excl constraint.
+const exclusionViolation = "23P01"
+
+type ReservationRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *ReservationRepository {
+	return &ReservationRepository{
+		db: db,
+	}
+}
+
+// Save Stores the reservation unless its room is out of order or occupied
+// by a stay without a reservation on some of its nights, or the inventory of
+// its room type does not allow the stay. The
+// room is locked in share mode so blocks created concurrently wait for the
+// reservation, and the other way around.
+func (r *ReservationRepository) Save(ctx context.Context, reservation *Reservation) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var roomTypeID uuid.UUID
+		err := tx.NewSelect().
+			TableExpr("rooms").
+			ColumnExpr("room_type_id").
+			Where("id = ?", reservation.RoomID).
+			For("SHARE").
+			Scan(ctx, &roomTypeID)
+		if err != nil {
+			return err
+		}
+
+		blocked, err := tx.NewSelect().
+			TableExpr("room_blocks").
+			Where("room_id = ?", reservation.RoomID).
+			Where(
+				"daterange(start_date, end_date, '[)') && daterange(?::date, ?::date, '[)')",
+				reservation.CheckIn, reservation.CheckOut,
+			).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrRoomOutOfOrder
+		}
+
+		// Stays checked in without a reservation hold their room until their
+		// expected check-out, or tonight when they overstay
+		occupied, err := tx.NewSelect().
+			TableExpr("stays").
+			Where("room_id = ?", reservation.RoomID).
+			Where("status = 'in_house' AND reservation_id IS NULL").
+			Where(
+				"daterange(CURRENT_DATE, GREATEST(expected_check_out, CURRENT_DATE + 1), '[)') && "+
+					"daterange(?::date, ?::date, '[)')",
+				reservation.CheckIn, reservation.CheckOut,
+			).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if occupied {
+			return ErrRoomAlreadyBooked
+		}
+
+		err = inventory.CheckStay(ctx, tx, reservation.HotelID, roomTypeID, reservation.CheckIn, reservation.CheckOut)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewInsert().
+			Model(reservation).
+			Exec(ctx)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+				return ErrRoomAlreadyBooked
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *ReservationRepository) Cancel(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewUpdate().
+		Model((*Reservation)(nil)).
+		Set("status = ?", CANCELLED).
+		Set("updated_at = ?", time.Now().UTC()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ReservationRepository) GetByID(ctx context.Context, id uuid.UUID) (*Reservation, error) {
+	var reservation Reservation
+	err := r.db.NewSelect().
+		Model(&reservation).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (r *ReservationRepository) GetByHotelID(ctx context.Context, hotelID uuid.UUID) (Reservations, error) {
+	var reservations Reservations
+	err := r.db.NewSelect().
+		Model(&reservations).
+		Where("hotel_id = ?", hotelID).
+		Order("check_in ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
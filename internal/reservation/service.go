@@ -0,0 +1,196 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type ReservationService struct {
+	reservationRepo *ReservationRepository
+	hotelValidator  core.HotelValidator
+	roomValidator   core.RoomValidator
+	roomOccupancy   core.RoomOccupancyResolver
+	guestDirectory  core.GuestDirectory
+	log             *zap.SugaredLogger
+}
+
+func NewService(
+	reservationRepo *ReservationRepository,
+	hotelValidator core.HotelValidator,
+	roomValidator core.RoomValidator,
+	roomOccupancy core.RoomOccupancyResolver,
+	guestDirectory core.GuestDirectory,
+) *ReservationService {
+	return &ReservationService{
+		reservationRepo: reservationRepo,
+		hotelValidator:  hotelValidator,
+		roomValidator:   roomValidator,
+		roomOccupancy:   roomOccupancy,
+		guestDirectory:  guestDirectory,
+		log:             logger.GetLogger(),
+	}
+}
+
+func (s *ReservationService) ListReservationsByHotelID(ctx context.Context, hotelID uuid.UUID) (Reservations, error) {
+	reservations, err := s.reservationRepo.GetByHotelID(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving reservations by hotel ID", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+
+	guestReservations := make([]*Reservation, len(reservations))
+	for i := range reservations {
+		guestReservations[i] = &reservations[i]
+	}
+	if err := s.resolveGuests(ctx, hotelID, guestReservations...); err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (s *ReservationService) RetrieveReservationByHotelReservationID(
+	ctx context.Context, hotelID uuid.UUID, reservationID uuid.UUID,
+) (*Reservation, error) {
+	reservation, err := s.reservationRepo.GetByID(ctx, reservationID)
+	if err != nil {
+		s.log.Errorw("error retrieving reservation", "reservationID", reservationID, "error", err)
+		return nil, err
+	}
+
+	if reservation == nil || reservation.HotelID != hotelID {
+		s.log.Errorw(
+			"hotel does not have the reservation with the provided ID",
+			"hotelID", hotelID, "reservationID", reservationID,
+		)
+		return nil, ErrReservationNotFound
+	}
+
+	if err := s.resolveGuests(ctx, hotelID, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *ReservationService) CreateReservation(ctx context.Context, r *Reservation) (*Reservation, error) {
+	if !r.CheckOut.After(r.CheckIn) {
+		return nil, ErrInvalidStayDates
+	}
+
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, r.HotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", r.HotelID, "error", err)
+		return nil, err
+	}
+	if !hotelExist {
+		s.log.Errorw("hotel does not exist", "hotelID", r.HotelID)
+		return nil, errors.New("hotel does not exist")
+	}
+
+	roomExist, err := s.roomValidator.ValidateHotelRoomExists(ctx, r.HotelID, r.RoomID)
+	if err != nil {
+		s.log.Errorw("error validating room existence", "roomID", r.RoomID, "error", err)
+		return nil, err
+	}
+	if !roomExist {
+		s.log.Errorw("hotel does not have the room with the provided ID", "hotelID", r.HotelID, "roomID", r.RoomID)
+		return nil, ErrRoomNotFound
+	}
+
+	maxOccupancy, err := s.roomOccupancy.ResolveRoomMaxOccupancy(ctx, r.HotelID, r.RoomID)
+	if err != nil {
+		s.log.Errorw("error resolving room occupancy", "roomID", r.RoomID, "error", err)
+		return nil, err
+	}
+	if r.Guests > maxOccupancy {
+		return nil, newPartyTooLargeError(r.Guests, maxOccupancy)
+	}
+
+	if err := s.linkGuest(ctx, r); err != nil {
+		return nil, err
+	}
+
+	// Overlapping stays are rejected by the reservations_room_stay_excl
+	// constraint, so concurrent requests cannot both succeed.
+	if err := s.reservationRepo.Save(ctx, r); err != nil {
+		s.log.Errorw("error creating new reservation", "roomID", r.RoomID, "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("reservation created successfully", "reservationID", r.ID, "roomID", r.RoomID)
+
+	return r, nil
+}
+
+func (s *ReservationService) CancelReservation(ctx context.Context, hotelID uuid.UUID, reservationID uuid.UUID) error {
+	reservation, err := s.RetrieveReservationByHotelReservationID(ctx, hotelID, reservationID)
+	if err != nil {
+		return err
+	}
+
+	if reservation.Status == string(CANCELLED) {
+		return nil
+	}
+
+	if err := s.reservationRepo.Cancel(ctx, reservation.ID); err != nil {
+		s.log.Errorw("error cancelling reservation", "reservationID", reservationID, "error", err)
+		return err
+	}
+
+	s.log.Infow("reservation cancelled successfully", "reservationID", reservationID)
+
+	return nil
+}
+
+// linkGuest Links the reservation to its guest profile, the hotel's guest
+// with its guest email when none was given, and reads the guest name and
+// email from the profile
+func (s *ReservationService) linkGuest(ctx context.Context, r *Reservation) error {
+	if r.GuestID.IsNil() {
+		guestID, err := s.guestDirectory.FindOrCreateGuest(ctx, r.HotelID, r.GuestName, r.GuestEmail)
+		if err != nil {
+			s.log.Errorw("error finding reservation guest", "hotelID", r.HotelID, "error", err)
+			return err
+		}
+		r.GuestID = guestID
+	}
+
+	contacts, err := s.guestDirectory.ResolveGuestContacts(ctx, r.HotelID, []uuid.UUID{r.GuestID})
+	if err != nil {
+		s.log.Errorw("error resolving reservation guest", "guestID", r.GuestID, "error", err)
+		return err
+	}
+	contact, ok := contacts[r.GuestID]
+	if !ok {
+		return ErrGuestNotFound
+	}
+	r.GuestName, r.GuestEmail = contact.Name, contact.Email
+	return nil
+}
+
+// resolveGuests Reads the guest names and emails of the hotel's reservations
+// from their guest profiles
+func (s *ReservationService) resolveGuests(ctx context.Context, hotelID uuid.UUID, reservations ...*Reservation) error {
+	guestIDs := make([]uuid.UUID, 0, len(reservations))
+	for _, r := range reservations {
+		if !r.GuestID.IsNil() {
+			guestIDs = append(guestIDs, r.GuestID)
+		}
+	}
+
+	contacts, err := s.guestDirectory.ResolveGuestContacts(ctx, hotelID, guestIDs)
+	if err != nil {
+		s.log.Errorw("error resolving reservation guests", "hotelID", hotelID, "error", err)
+		return err
+	}
+	for _, r := range reservations {
+		contact := contacts[r.GuestID]
+		r.GuestName, r.GuestEmail = contact.Name, contact.Email
+	}
+	return nil
+}
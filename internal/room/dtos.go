@@ -22,6 +22,11 @@ type UpdateRoomRequest struct {
 	Status     *string    `json:"status"`
 }
 
+type ChangeRoomStatusRequest struct {
+	Status string `json:"status" validate:"required"`
+	Reason string `json:"reason" validate:"max=512"`
+}
+
 type RoomResponse struct {
 	ID         uuid.UUID `json:"id"`
 	CreatedAt  string    `json:"created_at"`
@@ -32,14 +37,16 @@ type RoomResponse struct {
 	Number     int       `json:"number"`
 	Name       string    `json:"name"`
 	Status     string    `json:"status"`
+	DeletedAt  string    `json:"deleted_at,omitempty"`
 }
 
 type ListRoomsResponse struct {
-	Results []RoomResponse `json:"results"`
+	Results    []RoomResponse `json:"results"`
+	NextCursor string         `json:"next_cursor,omitempty"`
 }
 
 func NewRoomResponse(r *Room) RoomResponse {
-	return RoomResponse{
+	resp := RoomResponse{
 		ID:         r.ID,
 		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:  r.UpdatedAt.Format(time.RFC3339),
@@ -50,14 +57,66 @@ func NewRoomResponse(r *Room) RoomResponse {
 		Name:       r.Name,
 		Status:     r.Status,
 	}
+	if !r.DeletedAt.IsZero() {
+		resp.DeletedAt = r.DeletedAt.Format(time.RFC3339)
+	}
+	return resp
 }
 
-func NewListRoomsResponse(rooms Rooms) ListRoomsResponse {
+func NewListRoomsResponse(rooms Rooms, nextCursor string) ListRoomsResponse {
 	roomsResponse := make([]RoomResponse, len(rooms))
 	for i, room := range rooms {
 		roomsResponse[i] = NewRoomResponse(&room)
 	}
 	return ListRoomsResponse{
-		Results: roomsResponse,
+		Results:    roomsResponse,
+		NextCursor: nextCursor,
+	}
+}
+
+// RoomStatusChangedPayload data of the RoomStatusChanged domain event
+type RoomStatusChangedPayload struct {
+	RoomID         uuid.UUID `json:"room_id"`
+	Number         int       `json:"number"`
+	PreviousStatus string    `json:"previous_status"`
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason,omitempty"`
+}
+
+type StatusTransitionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  string    `json:"created_at"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+}
+
+type ListStatusTransitionsResponse struct {
+	Results    []StatusTransitionResponse `json:"results"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+}
+
+func NewStatusTransitionResponse(t *StatusTransition) StatusTransitionResponse {
+	return StatusTransitionResponse{
+		ID:         t.ID,
+		CreatedAt:  t.CreatedAt.Format(time.RFC3339),
+		FromStatus: t.FromStatus,
+		ToStatus:   t.ToStatus,
+		Actor:      t.Actor,
+		RequestID:  t.RequestID,
+		Reason:     t.Reason,
+	}
+}
+
+func NewListStatusTransitionsResponse(transitions StatusTransitions, nextCursor string) ListStatusTransitionsResponse {
+	responses := make([]StatusTransitionResponse, len(transitions))
+	for i, transition := range transitions {
+		responses[i] = NewStatusTransitionResponse(&transition)
+	}
+	return ListStatusTransitionsResponse{
+		Results:    responses,
+		NextCursor: nextCursor,
 	}
 }
@@ -1,7 +1,43 @@
 package room
 
-import "errors"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
 
 var (
-	ErrRoomNotFound = errors.New("room not found")
+	ErrRoomNotFound          = terrors.NotFound("room", "room not found", nil)
+	ErrRoomTypeNotFound      = terrors.NotFound("room_type", "hotel does not have the room type", nil)
+	ErrInvalidFloorFilter    = terrors.BadRequest("floor", "floor must be a number", nil)
+	ErrInvalidRoomTypeFilter = terrors.BadRequest("room_type_id", "room_type_id must be a valid identifier", nil)
+	ErrRoomNotDeleted        = core.Conflict("room_not_deleted", "room is not deleted", nil)
+	ErrRoomParentDeleted     = core.Conflict("room_parent_deleted", "restore the room's hotel and room type before the room", nil)
+	ErrInvalidStatus         = terrors.BadRequest(
+		"status",
+		"status must be one of available, occupied, dirty, cleaning, inspected, out_of_order or out_of_service",
+		nil,
+	)
+	ErrStatusNotUpdatable = terrors.BadRequest(
+		"status", "room status can only be changed through POST /v1/hotels/{hotel_id}/rooms/{room_id}/status", nil,
+	)
+	ErrStatusChanged = core.Conflict("room_status_changed", "room status changed concurrently, retry the transition", nil)
 )
+
+// newIllegalTransitionError Error of a room that can not move from one
+// status to another, it lists the statuses the room can move into
+func newIllegalTransitionError(from string, to string) error {
+	allowed := make([]string, 0, len(AllowedTransitions(from)))
+	for _, status := range AllowedTransitions(from) {
+		allowed = append(allowed, string(status))
+	}
+
+	return core.Conflict(
+		"illegal_status_transition",
+		fmt.Sprintf("room can not move from %s to %s, it can move to %s", from, to, strings.Join(allowed, ", ")),
+		map[string]string{"from": from, "to": to, "allowed": strings.Join(allowed, ",")},
+	)
+}
@@ -0,0 +1,230 @@
+package room
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	hotelv1 "github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1"
+	grpcserver "github.com/sebenitezg/hotel-service/pkg/server/grpc"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RoomGRPCController struct {
+	hotelv1.UnimplementedRoomServiceServer
+	roomService *RoomService
+	log         *zap.SugaredLogger
+}
+
+func NewGRPCController(
+	server *grpcserver.GRPCServer,
+	roomService *RoomService,
+	membershipChecker auth.HotelMembershipChecker,
+) *RoomGRPCController {
+	c := &RoomGRPCController{
+		roomService: roomService,
+		log:         logger.GetLogger(),
+	}
+
+	hotelv1.RegisterRoomServiceServer(server.Server, c)
+	server.RequireRoles(hotelv1.RoomService_GetRoom_FullMethodName, auth.RoleRoomRead)
+	server.RequireRoles(hotelv1.RoomService_ListRooms_FullMethodName, auth.RoleRoomRead)
+	server.RequireRoles(hotelv1.RoomService_CreateRoom_FullMethodName, auth.RoleRoomWrite)
+	server.RequireRoles(hotelv1.RoomService_UpdateRoom_FullMethodName, auth.RoleRoomWrite)
+	server.RequireRoles(hotelv1.RoomService_DeleteRoom_FullMethodName, auth.RoleRoomWrite)
+	server.RequireHotelMember(hotelv1.RoomService_GetRoom_FullMethodName, membershipChecker)
+	server.RequireHotelMember(hotelv1.RoomService_ListRooms_FullMethodName, membershipChecker)
+	server.RequireHotelMember(hotelv1.RoomService_CreateRoom_FullMethodName, membershipChecker, string(membership.MANAGER))
+	server.RequireHotelMember(hotelv1.RoomService_UpdateRoom_FullMethodName, membershipChecker, string(membership.MANAGER))
+	server.RequireHotelMember(hotelv1.RoomService_DeleteRoom_FullMethodName, membershipChecker, string(membership.MANAGER))
+
+	return c
+}
+
+func (c *RoomGRPCController) GetRoom(ctx context.Context, req *hotelv1.GetRoomRequest) (*hotelv1.Room, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomID, err := uuid.FromString(req.GetRoomId())
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", req.GetRoomId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room id")
+	}
+
+	room, err := c.roomService.RetrieveRoomByHotelRoomID(ctx, uuidHotelID, uuidRoomID, false)
+	if err == ErrRoomNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomMessage(room), nil
+}
+
+func (c *RoomGRPCController) ListRooms(ctx context.Context, req *hotelv1.ListRoomsRequest) (*hotelv1.ListRoomsResponse, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	page, err := pagination.NewParams(
+		int(req.GetLimit()), req.GetCursor(), req.GetSort(), SortableColumns, DefaultSort,
+	)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	filters := RoomFilters{
+		Status: req.GetStatus(),
+		Floor:  int32ToIntPtr(req.Floor),
+	}
+	if req.RoomTypeId != nil {
+		roomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+		if err != nil {
+			return nil, grpcserver.Error(ErrInvalidRoomTypeFilter)
+		}
+		filters.RoomTypeID = &roomTypeID
+	}
+
+	rooms, nextCursor, err := c.roomService.ListRoomsByHotelID(ctx, uuidHotelID, filters, page)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	results := make([]*hotelv1.Room, len(rooms))
+	for i, room := range rooms {
+		results[i] = newRoomMessage(&room)
+	}
+
+	return &hotelv1.ListRoomsResponse{Results: results, NextCursor: nextCursor}, nil
+}
+
+func (c *RoomGRPCController) CreateRoom(ctx context.Context, req *hotelv1.CreateRoomRequest) (*hotelv1.Room, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", req.GetRoomTypeId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room type id")
+	}
+
+	room, err := NewRoom(
+		uuidHotelID,
+		uuidRoomTypeID,
+		int(req.GetFloor()),
+		int(req.GetNumber()),
+		req.GetName(),
+		req.GetStatus(),
+	)
+	if err != nil {
+		c.log.Errorw("failure creating room instance", "error", err)
+		return nil, grpcserver.Error(err)
+	}
+
+	room, err = c.roomService.CreateRoom(ctx, core.ActorFromContext(ctx), room)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomMessage(room), nil
+}
+
+func (c *RoomGRPCController) UpdateRoom(ctx context.Context, req *hotelv1.UpdateRoomRequest) (*hotelv1.Room, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomID, err := uuid.FromString(req.GetRoomId())
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", req.GetRoomId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room id")
+	}
+
+	var roomTypeID *uuid.UUID
+	if req.RoomTypeId != nil {
+		uuidRoomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+		if err != nil {
+			c.log.Errorw("invalid room type id", "roomTypeID", req.GetRoomTypeId(), "error", err)
+			return nil, status.Error(codes.InvalidArgument, "invalid room type id")
+		}
+		roomTypeID = &uuidRoomTypeID
+	}
+
+	room, err := c.roomService.UpdatePartiallyRoom(
+		ctx,
+		core.ActorFromContext(ctx),
+		uuidRoomID,
+		uuidHotelID,
+		roomTypeID,
+		int32ToIntPtr(req.Floor),
+		int32ToIntPtr(req.Number),
+		req.Name,
+		req.Status,
+	)
+	if err == ErrRoomNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomMessage(room), nil
+}
+
+func (c *RoomGRPCController) DeleteRoom(ctx context.Context, req *hotelv1.DeleteRoomRequest) (*emptypb.Empty, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomID, err := uuid.FromString(req.GetRoomId())
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", req.GetRoomId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room id")
+	}
+
+	if err := c.roomService.DeleteRoom(ctx, core.ActorFromContext(ctx), uuidHotelID, uuidRoomID); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func newRoomMessage(r *Room) *hotelv1.Room {
+	return &hotelv1.Room{
+		Id:         r.ID.String(),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		UpdatedAt:  timestamppb.New(r.UpdatedAt),
+		HotelId:    r.HotelID.String(),
+		RoomTypeId: r.RoomTypeID.String(),
+		Floor:      int32(r.Floor),
+		Number:     int32(r.Number),
+		Name:       r.Name,
+		Status:     r.Status,
+	}
+}
+
+func int32ToIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
@@ -4,13 +4,21 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
 	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
 	"go.uber.org/zap"
 )
 
@@ -24,6 +32,7 @@ func NewController(
 	server *rest.HTTPServer,
 	validator *validator.Validate,
 	roomService *RoomService,
+	membershipChecker auth.HotelMembershipChecker,
 ) *RoomController {
 	c := &RoomController{
 		validator:   validator,
@@ -32,11 +41,21 @@ func NewController(
 	}
 
 	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
 		r.Get("/v1/hotels/{hotel_id}/rooms", c.handleListHotelRooms)
 		r.Get("/v1/hotels/{hotel_id}/rooms/{room_id}", c.handleGetHotelRoom)
+		r.Get("/v1/hotels/{hotel_id}/rooms/{room_id}/status/history", c.handleListRoomStatusHistory)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
 		r.Post("/v1/hotels/{hotel_id}/rooms", c.handleCreateHotelRoom)
 		r.Put("/v1/hotels/{hotel_id}/rooms/{room_id}", c.handlePartialUpdateHotelRoom)
 		r.Delete("/v1/hotels/{hotel_id}/rooms/{room_id}", c.handleDeleteHotelRoom)
+		r.Post("/v1/hotels/{hotel_id}/rooms/{room_id}:restore", c.handleRestoreHotelRoom)
+		r.Post("/v1/hotels/{hotel_id}/rooms/{room_id}/status", c.handleChangeRoomStatus)
 	})
 
 	return c
@@ -50,13 +69,50 @@ func (c *RoomController) handleListHotelRooms(w http.ResponseWriter, r *http.Req
 		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
 		return
 	}
-	hotelRooms, err := c.roomService.ListRoomsByHotelID(uuidHotelID)
+
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	includeDeleted, err := middleware.IncludeDeleted(r)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := RoomFilters{
+		Status:         query.Get("status"),
+		IncludeDeleted: includeDeleted,
+	}
+	if rawFloor := query.Get("floor"); rawFloor != "" {
+		floor, err := strconv.Atoi(rawFloor)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidFloorFilter)
+			return
+		}
+		filters.Floor = &floor
+	}
+	if rawRoomTypeID := query.Get("room_type_id"); rawRoomTypeID != "" {
+		roomTypeID, err := uuid.FromString(rawRoomTypeID)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidRoomTypeFilter)
+			return
+		}
+		filters.RoomTypeID = &roomTypeID
+	}
+
+	hotelRooms, nextCursor, err := c.roomService.ListRoomsByHotelID(r.Context(), uuidHotelID, filters, page)
 	if err != nil {
 		c.log.Errorw("error retrieving hotel's rooms", "hotelID", hotelID, "error", err)
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
-	resp := NewListRoomsResponse(hotelRooms)
+	resp := NewListRoomsResponse(hotelRooms, nextCursor)
 
 	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
@@ -78,13 +134,21 @@ func (c *RoomController) handleGetHotelRoom(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	resp, err := c.roomService.RetrieveRoomByHotelRoomID(uuidHotelID, uuidRoomID)
+	includeDeleted, err := middleware.IncludeDeleted(r)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
 		return
 	}
 
-	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+	room, err := c.roomService.RetrieveRoomByHotelRoomID(r.Context(), uuidHotelID, uuidRoomID, includeDeleted)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRoomResponse(room)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
 
 func (c *RoomController) handleCreateHotelRoom(w http.ResponseWriter, r *http.Request) {
@@ -103,6 +167,7 @@ func (c *RoomController) handleCreateHotelRoom(w http.ResponseWriter, r *http.Re
 		return
 	}
 	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
 		c.log.Errorw("validation error", "error", err)
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -119,9 +184,10 @@ func (c *RoomController) handleCreateHotelRoom(w http.ResponseWriter, r *http.Re
 	if err != nil {
 		c.log.Errorw("failure creating hotel instance", "error", err)
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
-	room, err = c.roomService.CreateRoom(room)
+	room, err = c.roomService.CreateRoom(r.Context(), core.ActorFromContext(r.Context()), room)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -146,6 +212,7 @@ func (c *RoomController) handlePartialUpdateHotelRoom(w http.ResponseWriter, r *
 	if err != nil {
 		c.log.Errorw("invalid room id", "romID", roomID, "error", err)
 		rest.RenderError(r.Context(), w, errors.New("room does not exist"))
+		return
 	}
 
 	var payload UpdateRoomRequest
@@ -155,12 +222,15 @@ func (c *RoomController) handlePartialUpdateHotelRoom(w http.ResponseWriter, r *
 		return
 	}
 	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
 		c.log.Errorw("validation error", "error", err)
 		rest.RenderError(r.Context(), w, err)
 		return
 	}
 
 	hotel, err := c.roomService.UpdatePartiallyRoom(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
 		uuidRoomID,
 		uuidHotelID,
 		payload.RoomTypeID,
@@ -180,5 +250,136 @@ func (c *RoomController) handlePartialUpdateHotelRoom(w http.ResponseWriter, r *
 }
 
 func (c *RoomController) handleDeleteHotelRoom(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	roomID := chi.URLParam(r, "room_id")
+	uuidRoomID, err := uuid.FromString(roomID)
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", roomID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomNotFound)
+		return
+	}
+
+	if err := c.roomService.DeleteRoom(r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRoomID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *RoomController) handleRestoreHotelRoom(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	roomID := chi.URLParam(r, "room_id")
+	uuidRoomID, err := uuid.FromString(roomID)
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", roomID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomNotFound)
+		return
+	}
+
+	room, err := c.roomService.RestoreRoom(r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRoomID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
 
+	resp := NewRoomResponse(room)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RoomController) handleChangeRoomStatus(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	roomID := chi.URLParam(r, "room_id")
+	uuidRoomID, err := uuid.FromString(roomID)
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", roomID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomNotFound)
+		return
+	}
+
+	var payload ChangeRoomStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	room, err := c.roomService.ChangeRoomStatus(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
+		uuidHotelID,
+		uuidRoomID,
+		payload.Status,
+		payload.Reason,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRoomResponse(room)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *RoomController) handleListRoomStatusHistory(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("hotel does not exist"))
+		return
+	}
+
+	roomID := chi.URLParam(r, "room_id")
+	uuidRoomID, err := uuid.FromString(roomID)
+	if err != nil {
+		c.log.Errorw("invalid room id", "roomID", roomID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomNotFound)
+		return
+	}
+
+	page, err := pagination.ParseQuery(r.URL.Query(), TransitionSortableColumns, DefaultTransitionSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	transitions, nextCursor, err := c.roomService.ListRoomStatusTransitions(r.Context(), uuidHotelID, uuidRoomID, page)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListStatusTransitionsResponse(transitions, nextCursor)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
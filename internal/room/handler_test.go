@@ -0,0 +1,52 @@
+package room_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestListRoomsAbortsQueryWhenRequestCancelled(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT .* FROM "rooms"`).
+		WillDelayFor(queryDelay).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	server := rest.NewHTTPServer(config.ServerConfigurations{})
+	// Administrators skip the membership check, no checker is needed
+	room.NewController(server, validator.New(), room.NewService(room.NewRepository(db), nil, nil), nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cancelAfter)
+	defer cancel()
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{
+		Subject: "admin",
+		Roles:   []string{auth.RoleAdmin, auth.RoleRoomRead},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/hotels/"+uuid.Must(uuid.NewV6()).String()+"/rooms", nil)
+	rec := httptest.NewRecorder()
+
+	started := time.Now()
+	server.Router.ServeHTTP(rec, req.WithContext(ctx))
+
+	if elapsed := time.Since(started); elapsed >= queryDelay {
+		t.Fatalf("request ran for %s instead of its query being aborted", elapsed)
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,12 +1,41 @@
 package room
 
 import (
+	"slices"
+	"strconv"
 	"time"
 
+	"github.com/sebenitezg/hotel-service/internal/core"
+
 	"github.com/gofrs/uuid/v5"
 	"github.com/uptrace/bun"
 )
 
+type Status string
+
+const (
+	AVAILABLE      Status = "available"
+	OCCUPIED       Status = "occupied"
+	DIRTY          Status = "dirty"
+	CLEANING       Status = "cleaning"
+	INSPECTED      Status = "inspected"
+	OUT_OF_ORDER   Status = "out_of_order"
+	OUT_OF_SERVICE Status = "out_of_service"
+)
+
+// transitions statuses a room can move into from each status. Rooms are
+// cleaned and inspected before being sold again, and rooms back from being
+// out of order or out of service are cleaned first too.
+var transitions = map[Status][]Status{
+	AVAILABLE:      {OCCUPIED, DIRTY, OUT_OF_ORDER, OUT_OF_SERVICE},
+	OCCUPIED:       {DIRTY},
+	DIRTY:          {CLEANING, OUT_OF_ORDER, OUT_OF_SERVICE},
+	CLEANING:       {DIRTY, INSPECTED, OUT_OF_ORDER},
+	INSPECTED:      {AVAILABLE, DIRTY, OUT_OF_ORDER, OUT_OF_SERVICE},
+	OUT_OF_ORDER:   {DIRTY, OUT_OF_SERVICE},
+	OUT_OF_SERVICE: {DIRTY, OUT_OF_ORDER},
+}
+
 // --------------------
 // DB models
 // --------------------
@@ -21,10 +50,46 @@ type Room struct {
 	Number        int       `bun:"number"`
 	Name          string    `bun:"name"`
 	Status        string    `bun:"status"`
+	DeletedAt     time.Time `bun:"deleted_at,soft_delete,nullzero"`
 }
 
 type Rooms []Room
 
+// RoomDependents number of live rows referencing a room that block its
+// deletion
+type RoomDependents struct {
+	Reservations int `bun:"reservations"`
+	Stays        int `bun:"stays"`
+	Folios       int `bun:"folios"`
+}
+
+// RoomFilters narrows down room listings, empty fields are ignored
+type RoomFilters struct {
+	Status         string
+	Floor          *int
+	RoomTypeID     *uuid.UUID
+	IncludeDeleted bool
+}
+
+// SortableColumns public sort keys of room listings and their columns
+var SortableColumns = map[string]string{
+	"number":     "number",
+	"floor":      "floor",
+	"created_at": "created_at",
+}
+
+const DefaultSort = "number"
+
+func cursorKey(room Room, column string) (string, uuid.UUID) {
+	switch column {
+	case "number":
+		return strconv.Itoa(room.Number), room.ID
+	case "floor":
+		return strconv.Itoa(room.Floor), room.ID
+	}
+	return room.CreatedAt.Format(time.RFC3339Nano), room.ID
+}
+
 func NewRoom(
 	hotelID uuid.UUID,
 	roomTypeID uuid.UUID,
@@ -37,6 +102,11 @@ func NewRoom(
 	if err != nil {
 		return nil, err
 	}
+
+	if status == "" {
+		status = string(AVAILABLE)
+	}
+
 	return &Room{
 		ID:         id,
 		CreatedAt:  time.Now().UTC(),
@@ -49,3 +119,78 @@ func NewRoom(
 		Status:     status,
 	}, nil
 }
+
+// ValidStatus Reports whether status is one of the room statuses
+func ValidStatus(status string) bool {
+	_, ok := transitions[Status(status)]
+	return ok
+}
+
+// AllowedTransitions Returns the statuses a room can move into from status
+func AllowedTransitions(status string) []Status {
+	return transitions[Status(status)]
+}
+
+// CanTransition Reports whether a room can move from one status to another
+func CanTransition(from string, to string) bool {
+	return slices.Contains(transitions[Status(from)], Status(to))
+}
+
+// StatusTransition change of status of a room, kept as its status history
+type StatusTransition struct {
+	bun.BaseModel `bun:"table:room_status_transitions"`
+	ID            uuid.UUID `bun:"id,pk"`
+	CreatedAt     time.Time `bun:"created_at"`
+	HotelID       uuid.UUID `bun:"hotel_id"`
+	RoomID        uuid.UUID `bun:"room_id"`
+	FromStatus    string    `bun:"from_status"`
+	ToStatus      string    `bun:"to_status"`
+	Actor         string    `bun:"actor"`
+	RequestID     string    `bun:"request_id"`
+	Reason        string    `bun:"reason"`
+}
+
+type StatusTransitions []StatusTransition
+
+// TransitionSortableColumns public sort keys of status history listings and
+// their columns
+var TransitionSortableColumns = map[string]string{
+	"created_at": "created_at",
+}
+
+// DefaultTransitionSort lists the most recent transitions first
+const DefaultTransitionSort = "-created_at"
+
+func transitionCursorKey(t StatusTransition, _ string) (string, uuid.UUID) {
+	return t.CreatedAt.Format(time.RFC3339Nano), t.ID
+}
+
+// StatusChange room moved into a new status along with the transition,
+// audit record and events storing it
+type StatusChange struct {
+	Room       *Room
+	Transition *StatusTransition
+	Record     core.AuditRecord
+	Events     []core.DomainEvent
+}
+
+// NewStatusTransition Records the room moving out of fromStatus into its
+// current status
+func NewStatusTransition(actor core.Actor, room *Room, fromStatus string, reason string) (*StatusTransition, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatusTransition{
+		ID:         id,
+		CreatedAt:  room.UpdatedAt,
+		HotelID:    room.HotelID,
+		RoomID:     room.ID,
+		FromStatus: fromStatus,
+		ToStatus:   room.Status,
+		Actor:      actor.Principal,
+		RequestID:  actor.RequestID,
+		Reason:     reason,
+	}, nil
+}
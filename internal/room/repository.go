@@ -4,6 +4,11 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/sebenitezg/hotel-service/internal/audit"
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
 	"github.com/gofrs/uuid/v5"
 	"github.com/uptrace/bun"
 )
@@ -18,42 +23,198 @@ func NewRepository(db *bun.DB) *RoomRepository {
 	}
 }
 
-func (r *RoomRepository) Save(room *Room) error {
-	_, err := r.db.NewInsert().Model(room).Exec(context.Background())
+// Save Creates the room and stores its audit record and the events in the
+// outbox within the same transaction
+func (r *RoomRepository) Save(
+	ctx context.Context, room *Room, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(room).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// Update Saves the room changes and stores their audit record and the events
+// in the outbox within the same transaction. The status is left out, it only
+// changes through UpdateStatus and ApplyStatusChange.
+func (r *RoomRepository) Update(
+	ctx context.Context, room *Room, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model(room).
+			Column("room_type_id", "floor", "number", "name", "updated_at").
+			Where("id = ?", room.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// UpdateStatus Stores the status change of the room within a transaction
+func (r *RoomRepository) UpdateStatus(ctx context.Context, change *StatusChange) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return ApplyStatusChange(ctx, tx, change)
+	})
+}
+
+// ApplyStatusChange Moves the room into its new status as long as it is
+// still in the transition's previous status, records the transition and
+// stores the audit record and the events in the outbox, using db, which is
+// expected to be a transaction
+func ApplyStatusChange(ctx context.Context, db bun.IDB, change *StatusChange) error {
+	res, err := db.NewUpdate().
+		Model(change.Room).
+		Column("status", "updated_at").
+		Where("id = ?", change.Room.ID).
+		Where("status = ?", change.Transition.FromStatus).
+		Exec(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrStatusChanged
+	}
+
+	if _, err := db.NewInsert().Model(change.Transition).Exec(ctx); err != nil {
+		return err
+	}
+	if err := audit.Append(ctx, db, change.Record); err != nil {
+		return err
+	}
+	return outbox.Enqueue(ctx, db, change.Events...)
 }
 
-func (r *RoomRepository) Update(room *Room) error {
-	_, err := r.db.NewUpdate().Model(room).Where("id = ?", room.ID).Exec(context.Background())
+// GetStatusTransitions Returns a page of the room's status history along
+// with the cursor of the next page
+func (r *RoomRepository) GetStatusTransitions(
+	ctx context.Context, roomID uuid.UUID, page pagination.Params,
+) (StatusTransitions, string, error) {
+	var transitions StatusTransitions
+	q := r.db.NewSelect().
+		Model(&transitions).
+		Where("room_id = ?", roomID)
+
+	err := page.Apply(q).Scan(ctx)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	return nil
+
+	transitions, nextCursor := pagination.Paginate(transitions, page, transitionCursorKey)
+
+	return transitions, nextCursor, nil
+}
+
+// Delete Soft deletes the room and stores the audit record and the events in
+// the outbox within the same transaction
+func (r *RoomRepository) Delete(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*Room)(nil)).Where("id = ?", id).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// Restore Undoes the soft deletion of the room and stores the audit record
+// and the events in the outbox within the same transaction
+func (r *RoomRepository) Restore(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*Room)(nil)).
+			Set("deleted_at = NULL").
+			Where("id = ?", id).
+			WhereDeleted().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
 }
 
-func (r *RoomRepository) Delete(id int64) error {
-	_, err := r.db.NewDelete().Model((*Room)(nil)).Where("id = ?", id).Exec(context.Background())
+// CountDependents Counts the room's reservations that are neither cancelled
+// nor already checked out, its stays in house and its open folios
+func (r *RoomRepository) CountDependents(ctx context.Context, id uuid.UUID) (RoomDependents, error) {
+	var dependents RoomDependents
+	err := r.db.NewSelect().
+		ColumnExpr(
+			"(SELECT COUNT(*) FROM reservations WHERE room_id = ? AND status <> 'cancelled' AND check_out > CURRENT_DATE) AS reservations",
+			id,
+		).
+		ColumnExpr("(SELECT COUNT(*) FROM stays WHERE room_id = ? AND checked_out_at IS NULL) AS stays", id).
+		ColumnExpr("(SELECT COUNT(*) FROM folios WHERE room_id = ? AND status = 'open') AS folios", id).
+		Scan(ctx, &dependents)
 	if err != nil {
-		return err
+		return RoomDependents{}, err
 	}
-	return nil
+	return dependents, nil
 }
 
-func (r *RoomRepository) GetAll() ([]Room, error) {
+// GetMaxOccupancy Returns the max occupancy of the room's room type
+func (r *RoomRepository) GetMaxOccupancy(ctx context.Context, id uuid.UUID) (int, error) {
+	var maxOccupancy int
+	err := r.db.NewSelect().
+		TableExpr("rooms AS r").
+		Join("JOIN room_types AS rt ON rt.id = r.room_type_id").
+		ColumnExpr("rt.max_occupancy").
+		Where("r.id = ?", id).
+		Scan(ctx, &maxOccupancy)
+	if err != nil {
+		return 0, err
+	}
+	return maxOccupancy, nil
+}
+
+func (r *RoomRepository) GetAll(ctx context.Context) ([]Room, error) {
 	var rooms []Room
-	err := r.db.NewSelect().Model(&rooms).Scan(context.Background())
+	err := r.db.NewSelect().Model(&rooms).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return rooms, nil
 }
 
-func (r *RoomRepository) GetByID(id uuid.UUID) (*Room, error) {
+func (r *RoomRepository) GetByID(ctx context.Context, id uuid.UUID) (*Room, error) {
+	var room Room
+	err := r.db.NewSelect().Model(&room).Where("id = ?", id).Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+// GetByIDWithDeleted Same as GetByID but also finds soft deleted rooms
+func (r *RoomRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*Room, error) {
 	var room Room
-	err := r.db.NewSelect().Model(&room).Where("id = ?", id).Scan(context.Background())
+	err := r.db.NewSelect().Model(&room).Where("id = ?", id).WhereAllWithDeleted().Scan(ctx)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -63,24 +224,44 @@ func (r *RoomRepository) GetByID(id uuid.UUID) (*Room, error) {
 	return &room, nil
 }
 
-func (r *RoomRepository) GetByHotelRoomID(hotelID, roomID uuid.UUID) (*Room, error) {
+func (r *RoomRepository) GetByHotelRoomID(ctx context.Context, hotelID, roomID uuid.UUID) (*Room, error) {
 	var room Room
-	err := r.db.NewSelect().Model(&room).Where("hotel_id = ? and id = ?", hotelID, roomID).Scan(context.Background())
+	err := r.db.NewSelect().Model(&room).Where("hotel_id = ? and id = ?", hotelID, roomID).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return &room, nil
 }
 
-func (r *RoomRepository) GetByHotelID(hotelID uuid.UUID) (Rooms, error) {
+// GetByHotelID Returns a page of the hotel's rooms matching the filters
+// along with the cursor of the next page
+func (r *RoomRepository) GetByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters RoomFilters, page pagination.Params,
+) (Rooms, string, error) {
 	var rooms Rooms
-	err := r.db.NewSelect().
+	q := r.db.NewSelect().
 		Model(&rooms).
-		Where("hotel_id = ?", hotelID).
-		Scan(context.Background())
+		Where("hotel_id = ?", hotelID)
+
+	if filters.IncludeDeleted {
+		q = q.WhereAllWithDeleted()
+	}
+	if filters.Status != "" {
+		q = q.Where("status = ?", filters.Status)
+	}
+	if filters.Floor != nil {
+		q = q.Where("floor = ?", *filters.Floor)
+	}
+	if filters.RoomTypeID != nil {
+		q = q.Where("room_type_id = ?", *filters.RoomTypeID)
+	}
+
+	err := page.Apply(q).Scan(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return rooms, nil
+	rooms, nextCursor := pagination.Paginate(rooms, page, cursorKey)
+
+	return rooms, nextCursor, nil
 }
@@ -0,0 +1,83 @@
+package room_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// queryDelay how long the mocked queries take unless their context is
+// cancelled, far longer than any test should run
+const queryDelay = time.Minute
+
+// cancelAfter how long a request runs before it is cancelled
+const cancelAfter = 50 * time.Millisecond
+
+func newMockDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqldb, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock database: %v", err)
+	}
+	db := bun.NewDB(sqldb, pgdialect.New())
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db, mock
+}
+
+func TestGetByHotelIDAbortsQueryWhenCancelled(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectQuery(`SELECT .* FROM "rooms"`).
+		WillDelayFor(queryDelay).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	page, err := pagination.NewParams(0, "", "", room.SortableColumns, room.DefaultSort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cancelAfter)
+	defer cancel()
+
+	started := time.Now()
+	_, _, err = room.NewRepository(db).GetByHotelID(ctx, uuid.Must(uuid.NewV6()), room.RoomFilters{}, page)
+
+	if err == nil {
+		t.Fatal("expected the cancelled query to fail")
+	}
+	if elapsed := time.Since(started); elapsed >= queryDelay {
+		t.Fatalf("query ran for %s instead of being aborted", elapsed)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSaveDoesNotBeginWhenCancelled(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := room.NewRepository(db).Save(ctx, &room.Room{ID: uuid.Must(uuid.NewV6())}, core.AuditRecord{})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	// No statement was expected, issuing one would have failed with an
+	// unexpected call error instead
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
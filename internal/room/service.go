@@ -1,20 +1,28 @@
 package room
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/sebenitezg/hotel-service/internal/core"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 
 	"github.com/gofrs/uuid/v5"
-	"go.uber.org/zap"
 )
 
+// entityType entity type of the rooms in the audit log and domain events
+const entityType = "room"
+
 type RoomService struct {
 	roomRepo          *RoomRepository
 	hotelValidator    core.HotelValidator
 	roomTypeValidator core.RoomTypeValidator
-	log               *zap.SugaredLogger
 }
 
 func NewService(
@@ -26,74 +34,100 @@ func NewService(
 		roomRepo:          roomRepo,
 		hotelValidator:    hotelValidator,
 		roomTypeValidator: roomTypeValidator,
-		log:               logger.GetLogger(),
 	}
 }
 
-func (s *RoomService) ListRoomsByHotelID(hotelID uuid.UUID) (Rooms, error) {
-	rooms, err := s.roomRepo.GetByHotelID(hotelID)
+func (s *RoomService) ListRoomsByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters RoomFilters, page pagination.Params,
+) (Rooms, string, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.ListRoomsByHotelID")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	rooms, nextCursor, err := s.roomRepo.GetByHotelID(ctx, hotelID, filters, page)
 	if err != nil {
-		s.log.Errorw("error retrieving rooms by hotel ID", "hotelID", hotelID, "error", err)
-		return nil, err
+		log.Errorw("error retrieving rooms by hotel ID", "hotelID", hotelID, "error", err)
+		return nil, "", tracing.Fail(span, err)
 	}
-	return rooms, nil
+	return rooms, nextCursor, nil
 }
 
+// RetrieveRoomByHotelRoomID Returns the hotel's room, soft deleted ones are
+// only found when includeDeleted is set
 func (s *RoomService) RetrieveRoomByHotelRoomID(
-	hotelID uuid.UUID, roomID uuid.UUID,
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, includeDeleted bool,
 ) (*Room, error) {
-	room, err := s.roomRepo.GetByID(roomID)
+	ctx, span := tracing.Start(ctx, "RoomService.RetrieveRoomByHotelRoomID")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	var (
+		room *Room
+		err  error
+	)
+	if includeDeleted {
+		room, err = s.roomRepo.GetByIDWithDeleted(ctx, roomID)
+	} else {
+		room, err = s.roomRepo.GetByID(ctx, roomID)
+	}
 	if err != nil {
-		return nil, err
+		return nil, tracing.Fail(span, err)
+	}
+
+	if room == nil {
+		log.Errorw("room not found", "roomID", roomID)
+		return nil, tracing.Fail(span, ErrRoomNotFound)
 	}
 
 	if room.HotelID != hotelID {
-		s.log.Errorw(
+		log.Errorw(
 			"error retrieving the room by hotel and room IDs",
 			"hotelID", hotelID, "roomID", roomID, "error", err,
 		)
-		return nil, errors.New("hotel doesn't have any room with the provided identifier")
+		return nil, tracing.Fail(span, ErrRoomNotFound)
 	}
 
 	return room, nil
 }
 
-func (s *RoomService) CreateRoom(r *Room) (*Room, error) {
+func (s *RoomService) CreateRoom(ctx context.Context, actor core.Actor, r *Room) (*Room, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.CreateRoom")
+	defer span.End()
+	log := logger.WithContext(ctx)
 
-	hotelExist, err := s.hotelValidator.ValidateHotelExists(r.HotelID)
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, r.HotelID)
 	if err != nil {
-		s.log.Errorw("error validating hotel existence", "hotelID", r.HotelID, "error", err)
-		return nil, err
+		log.Errorw("error validating hotel existence", "hotelID", r.HotelID, "error", err)
+		return nil, tracing.Fail(span, err)
 	}
 	if !hotelExist {
-		s.log.Errorw("hotel does not exist", "hotelID", r.HotelID)
-		return nil, errors.New("hotel does not exist")
+		log.Errorw("hotel does not exist", "hotelID", r.HotelID)
+		return nil, tracing.Fail(span, errors.New("hotel does not exist"))
 	}
 
-	roomTypeExist, err := s.roomTypeValidator.ValidateRoomTypeExists(r.RoomTypeID)
-	if err != nil {
-		s.log.Errorw(
-			"error validating room type existence",
-			"roomTypeID", r.RoomTypeID, "error", err,
-		)
-		return nil, err
+	if err := s.validateRoomType(ctx, r.HotelID, r.RoomTypeID); err != nil {
+		return nil, tracing.Fail(span, err)
 	}
-	if !roomTypeExist {
-		s.log.Errorw("room type does not exist", "roomTypeID", r.RoomTypeID)
-		return nil, errors.New("room type does not exist")
+
+	if !ValidStatus(r.Status) {
+		return nil, tracing.Fail(span, ErrInvalidStatus)
 	}
 
-	if err := s.roomRepo.Save(r); err != nil {
-		s.log.Errorw("error creating new room", "error", err)
-		return nil, err
+	record := newAuditRecord(actor, core.ActionCreate, r, nil, NewRoomResponse(r))
+	if err := s.roomRepo.Save(ctx, r, record, newEvent(core.RoomCreated, r)); err != nil {
+		log.Errorw("error creating new room", "error", err)
+		return nil, tracing.Fail(span, err)
 	}
+	metrics.RecordChange(entityType, core.ActionCreate)
 
-	s.log.Infow("room created successfully", "hotel_id", r.ID)
+	log.Infow("room created successfully", "hotel_id", r.ID)
 
 	return r, nil
 }
 
 func (s *RoomService) UpdatePartiallyRoom(
+	ctx context.Context,
+	actor core.Actor,
 	roomID uuid.UUID,
 	uuidHotelID uuid.UUID,
 	roomTypeID *uuid.UUID,
@@ -102,23 +136,31 @@ func (s *RoomService) UpdatePartiallyRoom(
 	name *string,
 	status *string,
 ) (*Room, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.UpdatePartiallyRoom")
+	defer span.End()
+	log := logger.WithContext(ctx)
 
-	room, err := s.roomRepo.GetByID(roomID)
+	room, err := s.roomRepo.GetByID(ctx, roomID)
 	if err != nil {
-		s.log.Errorw("failure updating partially room", "roomID", roomID, "error", err)
-		return nil, err
+		log.Errorw("failure updating partially room", "roomID", roomID, "error", err)
+		return nil, tracing.Fail(span, err)
 	}
 	if room == nil {
-		s.log.Errorw("room not found", "roomID", roomID)
-		return nil, errors.New("room not found")
+		log.Errorw("room not found", "roomID", roomID)
+		return nil, tracing.Fail(span, ErrRoomNotFound)
 	}
 
 	if room.HotelID != uuidHotelID {
-		s.log.Errorw("hotel does not have the room with the provided ID", "hotelID", uuidHotelID, "roomID", roomID)
-		return nil, errors.New("hotel does not have the room with the provided ID")
+		log.Errorw("hotel does not have the room with the provided ID", "hotelID", uuidHotelID, "roomID", roomID)
+		return nil, tracing.Fail(span, errors.New("hotel does not have the room with the provided ID"))
 	}
 
-	if roomTypeID != nil {
+	before := NewRoomResponse(room)
+
+	if roomTypeID != nil && *roomTypeID != room.RoomTypeID {
+		if err := s.validateRoomType(ctx, room.HotelID, *roomTypeID); err != nil {
+			return nil, tracing.Fail(span, err)
+		}
 		room.RoomTypeID = *roomTypeID
 	}
 	if floor != nil {
@@ -130,15 +172,302 @@ func (s *RoomService) UpdatePartiallyRoom(
 	if name != nil {
 		room.Name = *name
 	}
-	if status != nil {
-		room.Status = *status
+	// Status changes go through the state machine of ChangeRoomStatus
+	if status != nil && *status != room.Status {
+		return nil, tracing.Fail(span, ErrStatusNotUpdatable)
 	}
+	room.UpdatedAt = time.Now().UTC()
+
+	record := newAuditRecord(actor, core.ActionUpdate, room, before, NewRoomResponse(room))
+	err = s.roomRepo.Update(ctx, room, record, newEvent(core.RoomUpdated, room))
+	if err != nil {
+		log.Errorw("failure updating partially room", "roomID", roomID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionUpdate)
+
+	return room, nil
+}
 
-	err = s.roomRepo.Update(room)
+// ChangeRoomStatus Moves the room into status when the transition from its
+// current status is allowed, recording it in the room's status history
+func (s *RoomService) ChangeRoomStatus(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	status string,
+	reason string,
+) (*Room, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.ChangeRoomStatus")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	change, err := s.NewStatusChange(ctx, actor, hotelID, roomID, status, reason)
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	if err := s.roomRepo.UpdateStatus(ctx, change); err != nil {
+		log.Errorw("failure changing room status", "roomID", roomID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	s.StatusChanged(ctx, change)
+
+	return change.Room, nil
+}
+
+// NewStatusChange Returns the change moving the room into status, when the
+// transition from its current status is allowed, for the caller to store it
+// with ApplyStatusChange along with its own changes
+func (s *RoomService) NewStatusChange(
+	ctx context.Context,
+	actor core.Actor,
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	status string,
+	reason string,
+) (*StatusChange, error) {
+	log := logger.WithContext(ctx)
+
+	if !ValidStatus(status) {
+		return nil, ErrInvalidStatus
+	}
+
+	room, err := s.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !CanTransition(room.Status, status) {
+		log.Infow("illegal room status transition", "roomID", roomID, "from", room.Status, "to", status)
+		return nil, newIllegalTransitionError(room.Status, status)
+	}
+
+	before := NewRoomResponse(room)
+	room.Status = status
+	room.UpdatedAt = time.Now().UTC()
+
+	transition, err := NewStatusTransition(actor, room, before.Status, reason)
 	if err != nil {
-		s.log.Errorw("failure updating partially room", "roomID", roomID, "error", err)
 		return nil, err
 	}
 
+	return &StatusChange{
+		Room:       room,
+		Transition: transition,
+		Record:     newAuditRecord(actor, core.ActionUpdate, room, before, NewRoomResponse(room)),
+		Events: []core.DomainEvent{
+			newEvent(core.RoomUpdated, room),
+			newStatusChangedEvent(room, before.Status, reason),
+		},
+	}, nil
+}
+
+// StatusChanged Records the metrics of a status change once it is stored
+func (s *RoomService) StatusChanged(ctx context.Context, change *StatusChange) {
+	metrics.RecordChange(entityType, core.ActionUpdate)
+
+	logger.WithContext(ctx).Infow(
+		"room status changed successfully",
+		"roomID", change.Room.ID, "from", change.Transition.FromStatus, "to", change.Transition.ToStatus,
+	)
+}
+
+// ListRoomStatusTransitions Returns a page of the status history of the
+// hotel's room
+func (s *RoomService) ListRoomStatusTransitions(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, page pagination.Params,
+) (StatusTransitions, string, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.ListRoomStatusTransitions")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	if _, err := s.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true); err != nil {
+		return nil, "", tracing.Fail(span, err)
+	}
+
+	transitions, nextCursor, err := s.roomRepo.GetStatusTransitions(ctx, roomID, page)
+	if err != nil {
+		log.Errorw("error retrieving room status transitions", "roomID", roomID, "error", err)
+		return nil, "", tracing.Fail(span, err)
+	}
+	return transitions, nextCursor, nil
+}
+
+// DeleteRoom Soft deletes a room without upcoming reservations, stays in
+// house or open folios
+func (s *RoomService) DeleteRoom(ctx context.Context, actor core.Actor, hotelID uuid.UUID, roomID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "RoomService.DeleteRoom")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	room, err := s.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, false)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	dependents, err := s.roomRepo.CountDependents(ctx, room.ID)
+	if err != nil {
+		log.Errorw("failure counting room dependents", "roomID", roomID, "error", err)
+		return tracing.Fail(span, err)
+	}
+	if dependents.Reservations > 0 || dependents.Stays > 0 || dependents.Folios > 0 {
+		return tracing.Fail(span, core.Conflict(
+			"room_in_use",
+			fmt.Sprintf(
+				"room still has %d upcoming reservations, %d stays in house and %d open folios",
+				dependents.Reservations, dependents.Stays, dependents.Folios,
+			),
+			map[string]string{
+				"reservations": strconv.Itoa(dependents.Reservations),
+				"stays":        strconv.Itoa(dependents.Stays),
+				"folios":       strconv.Itoa(dependents.Folios),
+			},
+		))
+	}
+
+	record := newAuditRecord(actor, core.ActionDelete, room, NewRoomResponse(room), nil)
+	if err := s.roomRepo.Delete(ctx, room.ID, record, newEvent(core.RoomDeleted, room)); err != nil {
+		log.Errorw("failure deleting room", "roomID", roomID, "error", err)
+		return tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionDelete)
+
+	log.Infow("room deleted successfully", "hotelID", hotelID, "roomID", roomID)
+
+	return nil
+}
+
+// RestoreRoom Undoes the soft deletion of a room whose hotel and room type
+// are not deleted
+func (s *RoomService) RestoreRoom(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, roomID uuid.UUID,
+) (*Room, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.RestoreRoom")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	room, err := s.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true)
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+	if room.DeletedAt.IsZero() {
+		return nil, tracing.Fail(span, ErrRoomNotDeleted)
+	}
+
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	roomTypeExist, err := s.roomTypeValidator.ValidateHotelRoomTypeExists(ctx, hotelID, room.RoomTypeID)
+	if err != nil {
+		log.Errorw("error validating room type existence", "roomTypeID", room.RoomTypeID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	if !hotelExist || !roomTypeExist {
+		return nil, tracing.Fail(span, ErrRoomParentDeleted)
+	}
+
+	before := NewRoomResponse(room)
+	room.DeletedAt = time.Time{}
+
+	record := newAuditRecord(actor, core.ActionRestore, room, before, NewRoomResponse(room))
+	if err := s.roomRepo.Restore(ctx, room.ID, record, newEvent(core.RoomRestored, room)); err != nil {
+		log.Errorw("failure restoring room", "roomID", roomID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionRestore)
+
+	log.Infow("room restored successfully", "hotelID", hotelID, "roomID", roomID)
+
 	return room, nil
 }
+
+func (s *RoomService) ValidateHotelRoomExists(ctx context.Context, hotelID, roomID uuid.UUID) (bool, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.ValidateHotelRoomExists")
+	defer span.End()
+
+	room, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return false, tracing.Fail(span, err)
+	}
+	return room != nil && room.HotelID == hotelID, nil
+}
+
+// ResolveRoomMaxOccupancy Returns how many guests the hotel's room hosts
+func (s *RoomService) ResolveRoomMaxOccupancy(ctx context.Context, hotelID, roomID uuid.UUID) (int, error) {
+	ctx, span := tracing.Start(ctx, "RoomService.ResolveRoomMaxOccupancy")
+	defer span.End()
+
+	room, err := s.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, false)
+	if err != nil {
+		return 0, tracing.Fail(span, err)
+	}
+	maxOccupancy, err := s.roomRepo.GetMaxOccupancy(ctx, room.ID)
+	if err != nil {
+		return 0, tracing.Fail(span, err)
+	}
+	return maxOccupancy, nil
+}
+
+// validateRoomType Rejects a room type that is not one of the hotel's
+func (s *RoomService) validateRoomType(ctx context.Context, hotelID, roomTypeID uuid.UUID) error {
+	log := logger.WithContext(ctx)
+
+	roomTypeExist, err := s.roomTypeValidator.ValidateHotelRoomTypeExists(ctx, hotelID, roomTypeID)
+	if err != nil {
+		log.Errorw("error validating room type existence", "roomTypeID", roomTypeID, "error", err)
+		return err
+	}
+	if !roomTypeExist {
+		log.Errorw("hotel does not have the room type", "hotelID", hotelID, "roomTypeID", roomTypeID)
+		return ErrRoomTypeNotFound
+	}
+	return nil
+}
+
+// newAuditRecord Audit record of a change made to the room by the actor
+func newAuditRecord(actor core.Actor, action string, room *Room, before, after any) core.AuditRecord {
+	return core.AuditRecord{
+		Actor: actor,
+		Change: core.Change{
+			HotelID:    room.HotelID,
+			EntityType: entityType,
+			EntityID:   room.ID,
+			Action:     action,
+			Before:     before,
+			After:      after,
+		},
+	}
+}
+
+// newEvent Domain event of a change made to the room
+func newEvent(eventType string, room *Room) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          eventType,
+		HotelID:       room.HotelID,
+		AggregateType: entityType,
+		AggregateID:   room.ID,
+		Payload:       NewRoomResponse(room),
+	}
+}
+
+// newStatusChangedEvent Domain event of the room moving out of
+// previousStatus into its current status
+func newStatusChangedEvent(room *Room, previousStatus string, reason string) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          core.RoomStatusChanged,
+		HotelID:       room.HotelID,
+		AggregateType: entityType,
+		AggregateID:   room.ID,
+		Payload: RoomStatusChangedPayload{
+			RoomID:         room.ID,
+			Number:         room.Number,
+			PreviousStatus: previousStatus,
+			Status:         room.Status,
+			Reason:         reason,
+		},
+	}
+}
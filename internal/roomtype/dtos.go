@@ -35,14 +35,17 @@ type RoomTypeResponse struct {
 	BedType      string          `json:"bed_type"`
 	MaxOccupancy int             `json:"max_occupancy"`
 	BasePrice    decimal.Decimal `json:"base_price"`
+	Currency     string          `json:"currency"`
+	DeletedAt    string          `json:"deleted_at,omitempty"`
 }
 
 type ListRoomTypeResponse struct {
-	Results []RoomTypeResponse `json:"results"`
+	Results    []RoomTypeResponse `json:"results"`
+	NextCursor string             `json:"next_cursor,omitempty"`
 }
 
 func NewRoomTypeResponse(rt *RoomType) RoomTypeResponse {
-	return RoomTypeResponse{
+	resp := RoomTypeResponse{
 		ID:           rt.ID.String(),
 		CreatedAt:    rt.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:    rt.UpdatedAt.Format(time.RFC3339),
@@ -53,13 +56,18 @@ func NewRoomTypeResponse(rt *RoomType) RoomTypeResponse {
 		BedType:      rt.BedType,
 		MaxOccupancy: rt.MaxOccupancy,
 		BasePrice:    rt.BasePrice,
+		Currency:     rt.Currency,
 	}
+	if !rt.DeletedAt.IsZero() {
+		resp.DeletedAt = rt.DeletedAt.Format(time.RFC3339)
+	}
+	return resp
 }
 
-func NewListRoomTypesResponse(rts RoomTypes) ListRoomTypeResponse {
+func NewListRoomTypesResponse(rts RoomTypes, nextCursor string) ListRoomTypeResponse {
 	responses := make([]RoomTypeResponse, len(rts))
 	for i, rt := range rts {
 		responses[i] = NewRoomTypeResponse(&rt)
 	}
-	return ListRoomTypeResponse{Results: responses}
+	return ListRoomTypeResponse{Results: responses, NextCursor: nextCursor}
 }
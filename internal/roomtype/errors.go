@@ -1,7 +1,14 @@
 package roomtype
 
-import "errors"
+import (
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
 
 var (
-	ErrRoomTypeNotFound = errors.New("room type not found")
+	ErrRoomTypeNotFound   = terrors.NotFound("room_type", "room type not found", nil)
+	ErrInvalidPriceFilter = terrors.BadRequest("price", "min_price and max_price must be decimal numbers", nil)
+	ErrRoomTypeNotDeleted = core.Conflict("room_type_not_deleted", "room type is not deleted", nil)
+	ErrRoomTypeHotelGone  = core.Conflict("hotel_deleted", "restore the hotel before its room types", nil)
 )
@@ -0,0 +1,247 @@
+package roomtype
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	hotelv1 "github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1"
+	grpcserver "github.com/sebenitezg/hotel-service/pkg/server/grpc"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RoomTypeGRPCController struct {
+	hotelv1.UnimplementedRoomTypeServiceServer
+	roomTypeService *RoomTypeService
+	log             *zap.SugaredLogger
+}
+
+func NewGRPCController(
+	server *grpcserver.GRPCServer,
+	roomTypeService *RoomTypeService,
+	membershipChecker auth.HotelMembershipChecker,
+) *RoomTypeGRPCController {
+	c := &RoomTypeGRPCController{
+		roomTypeService: roomTypeService,
+		log:             logger.GetLogger(),
+	}
+
+	hotelv1.RegisterRoomTypeServiceServer(server.Server, c)
+	server.RequireRoles(hotelv1.RoomTypeService_GetRoomType_FullMethodName, auth.RoleRoomRead)
+	server.RequireRoles(hotelv1.RoomTypeService_ListRoomTypes_FullMethodName, auth.RoleRoomRead)
+	server.RequireRoles(hotelv1.RoomTypeService_CreateRoomType_FullMethodName, auth.RoleRoomWrite)
+	server.RequireRoles(hotelv1.RoomTypeService_UpdateRoomType_FullMethodName, auth.RoleRoomWrite)
+	server.RequireRoles(hotelv1.RoomTypeService_DeleteRoomType_FullMethodName, auth.RoleRoomWrite)
+	server.RequireHotelMember(hotelv1.RoomTypeService_GetRoomType_FullMethodName, membershipChecker)
+	server.RequireHotelMember(hotelv1.RoomTypeService_ListRoomTypes_FullMethodName, membershipChecker)
+	server.RequireHotelMember(hotelv1.RoomTypeService_CreateRoomType_FullMethodName, membershipChecker, string(membership.MANAGER))
+	server.RequireHotelMember(hotelv1.RoomTypeService_UpdateRoomType_FullMethodName, membershipChecker, string(membership.MANAGER))
+	server.RequireHotelMember(hotelv1.RoomTypeService_DeleteRoomType_FullMethodName, membershipChecker, string(membership.MANAGER))
+
+	return c
+}
+
+func (c *RoomTypeGRPCController) GetRoomType(ctx context.Context, req *hotelv1.GetRoomTypeRequest) (*hotelv1.RoomType, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", req.GetRoomTypeId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room type id")
+	}
+
+	roomType, err := c.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, uuidHotelID, uuidRoomTypeID, false)
+	if err == ErrRoomTypeNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomTypeMessage(roomType), nil
+}
+
+func (c *RoomTypeGRPCController) ListRoomTypes(
+	ctx context.Context, req *hotelv1.ListRoomTypesRequest,
+) (*hotelv1.ListRoomTypesResponse, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	page, err := pagination.NewParams(
+		int(req.GetLimit()), req.GetCursor(), req.GetSort(), SortableColumns, DefaultSort,
+	)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	filters := RoomTypeFilters{
+		BedType: req.GetBedType(),
+	}
+	if req.MinPrice != nil {
+		price, err := decimal.NewFromString(req.GetMinPrice())
+		if err != nil {
+			return nil, grpcserver.Error(ErrInvalidPriceFilter)
+		}
+		filters.MinPrice = &price
+	}
+	if req.MaxPrice != nil {
+		price, err := decimal.NewFromString(req.GetMaxPrice())
+		if err != nil {
+			return nil, grpcserver.Error(ErrInvalidPriceFilter)
+		}
+		filters.MaxPrice = &price
+	}
+
+	roomTypes, nextCursor, err := c.roomTypeService.ListRoomTypesByHotelID(ctx, uuidHotelID, filters, page)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	results := make([]*hotelv1.RoomType, len(roomTypes))
+	for i, roomType := range roomTypes {
+		results[i] = newRoomTypeMessage(&roomType)
+	}
+
+	return &hotelv1.ListRoomTypesResponse{Results: results, NextCursor: nextCursor}, nil
+}
+
+func (c *RoomTypeGRPCController) CreateRoomType(
+	ctx context.Context, req *hotelv1.CreateRoomTypeRequest,
+) (*hotelv1.RoomType, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+
+	basePrice, err := decimal.NewFromString(req.GetBasePrice())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid base price")
+	}
+
+	roomType, err := NewRoomType(
+		uuidHotelID,
+		req.GetName(),
+		req.GetDescription(),
+		int(req.GetNumberOfBeds()),
+		req.GetBedType(),
+		int(req.GetMaxOccupancy()),
+		basePrice,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating room type", "error", err)
+		return nil, grpcserver.Error(err)
+	}
+
+	roomType, err = c.roomTypeService.CreateRoomType(ctx, core.ActorFromContext(ctx), roomType)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomTypeMessage(roomType), nil
+}
+
+func (c *RoomTypeGRPCController) UpdateRoomType(
+	ctx context.Context, req *hotelv1.UpdateRoomTypeRequest,
+) (*hotelv1.RoomType, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", req.GetRoomTypeId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room type id")
+	}
+
+	var basePrice *decimal.Decimal
+	if req.BasePrice != nil {
+		price, err := decimal.NewFromString(req.GetBasePrice())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid base price")
+		}
+		basePrice = &price
+	}
+
+	roomType, err := c.roomTypeService.UpdatePartiallyRoomType(
+		ctx,
+		core.ActorFromContext(ctx),
+		uuidRoomTypeID,
+		uuidHotelID,
+		req.Name,
+		req.Description,
+		int32ToIntPtr(req.NumberOfBeds),
+		req.BedType,
+		int32ToIntPtr(req.MaxOccupancy),
+		basePrice,
+	)
+	if err == ErrRoomTypeNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return newRoomTypeMessage(roomType), nil
+}
+
+func (c *RoomTypeGRPCController) DeleteRoomType(
+	ctx context.Context, req *hotelv1.DeleteRoomTypeRequest,
+) (*emptypb.Empty, error) {
+	uuidHotelID, err := uuid.FromString(req.GetHotelId())
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", req.GetHotelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid hotel id")
+	}
+	uuidRoomTypeID, err := uuid.FromString(req.GetRoomTypeId())
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", req.GetRoomTypeId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid room type id")
+	}
+
+	if err := c.roomTypeService.DeleteRoomType(ctx, core.ActorFromContext(ctx), uuidHotelID, uuidRoomTypeID); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func newRoomTypeMessage(rt *RoomType) *hotelv1.RoomType {
+	return &hotelv1.RoomType{
+		Id:           rt.ID.String(),
+		CreatedAt:    timestamppb.New(rt.CreatedAt),
+		UpdatedAt:    timestamppb.New(rt.UpdatedAt),
+		HotelId:      rt.HotelID.String(),
+		Name:         rt.Name,
+		Description:  rt.Description,
+		NumberOfBeds: int32(rt.NumberOfBeds),
+		BedType:      rt.BedType,
+		MaxOccupancy: int32(rt.MaxOccupancy),
+		BasePrice:    rt.BasePrice.String(),
+	}
+}
+
+func int32ToIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
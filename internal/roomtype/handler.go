@@ -5,18 +5,27 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/currency"
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
 	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
 type RoomTypeController struct {
 	validator       *validator.Validate
 	roomTypeService *RoomTypeService
+	currencyService *currency.CurrencyService
 	log             *zap.SugaredLogger
 }
 
@@ -24,19 +33,30 @@ func NewController(
 	server *rest.HTTPServer,
 	validator *validator.Validate,
 	roomTypeService *RoomTypeService,
+	currencyService *currency.CurrencyService,
+	membershipChecker auth.HotelMembershipChecker,
 ) *RoomTypeController {
 	c := &RoomTypeController{
 		validator:       validator,
 		roomTypeService: roomTypeService,
+		currencyService: currencyService,
 		log:             logger.GetLogger(),
 	}
 
 	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
 		r.Get("/v1/hotels/{hotel_id}/roomtypes", c.handleListHotelRoomTypes)
 		r.Get("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}", c.handleGetHotelRoomType)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRoomWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
 		r.Post("/v1/hotels/{hotel_id}/roomtypes", c.handleCreateHotelRoomType)
 		r.Patch("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}", c.handlePartialUpdateHotelRoomType)
 		r.Delete("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}", c.handleDeleteHotelRoomType)
+		r.Post("/v1/hotels/{hotel_id}/roomtypes/{room_type_id}:restore", c.handleRestoreHotelRoomType)
 	})
 
 	return c
@@ -50,13 +70,53 @@ func (c *RoomTypeController) handleListHotelRoomTypes(w http.ResponseWriter, r *
 		rest.RenderError(r.Context(), w, errors.New("invalid hotel id"))
 		return
 	}
-	hotelRooms, err := c.roomTypeService.ListRoomTypesByHotelID(uuidHotelID)
+	query := r.URL.Query()
+
+	page, err := pagination.ParseQuery(query, SortableColumns, DefaultSort)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	includeDeleted, err := middleware.IncludeDeleted(r)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	filters := RoomTypeFilters{
+		BedType:        query.Get("bed_type"),
+		IncludeDeleted: includeDeleted,
+	}
+	if minPrice := query.Get("min_price"); minPrice != "" {
+		price, err := decimal.NewFromString(minPrice)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidPriceFilter)
+			return
+		}
+		filters.MinPrice = &price
+	}
+	if maxPrice := query.Get("max_price"); maxPrice != "" {
+		price, err := decimal.NewFromString(maxPrice)
+		if err != nil {
+			rest.RenderError(r.Context(), w, ErrInvalidPriceFilter)
+			return
+		}
+		filters.MaxPrice = &price
+	}
+
+	hotelRooms, nextCursor, err := c.roomTypeService.ListRoomTypesByHotelID(r.Context(), uuidHotelID, filters, page)
 	if err != nil {
 		c.log.Errorw("error retrieving hotel's room types", "hotelID", hotelID, "error", err)
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
-	resp := NewListRoomTypesResponse(hotelRooms)
+	resp := NewListRoomTypesResponse(hotelRooms, nextCursor)
+	if err := c.convertPrices(r, resp.Results); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
 
 	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
 }
@@ -78,13 +138,27 @@ func (c *RoomTypeController) handleGetHotelRoomType(w http.ResponseWriter, r *ht
 		return
 	}
 
-	resp, err := c.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(uuidHotelID, uuidRoomTypeID)
+	includeDeleted, err := middleware.IncludeDeleted(r)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
 		return
 	}
 
-	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+	roomType, err := c.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(
+		r.Context(), uuidHotelID, uuidRoomTypeID, includeDeleted,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := []RoomTypeResponse{NewRoomTypeResponse(roomType)}
+	if err := c.convertPrices(r, resp); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp[0])
 }
 
 func (c *RoomTypeController) handleCreateHotelRoomType(w http.ResponseWriter, r *http.Request) {
@@ -103,6 +177,7 @@ func (c *RoomTypeController) handleCreateHotelRoomType(w http.ResponseWriter, r
 		return
 	}
 	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
 		c.log.Errorw("validation error", "error", err)
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -120,9 +195,10 @@ func (c *RoomTypeController) handleCreateHotelRoomType(w http.ResponseWriter, r
 	if err != nil {
 		c.log.Errorw("failure creating room type", "error", err)
 		rest.RenderError(r.Context(), w, err)
+		return
 	}
 
-	roomType, err = c.roomTypeService.CreateRoomType(roomType)
+	roomType, err = c.roomTypeService.CreateRoomType(r.Context(), core.ActorFromContext(r.Context()), roomType)
 	if err != nil {
 		rest.RenderError(r.Context(), w, err)
 		return
@@ -147,6 +223,7 @@ func (c *RoomTypeController) handlePartialUpdateHotelRoomType(w http.ResponseWri
 	if err != nil {
 		c.log.Errorw("invalid room type id", "roomTypeID", roomID, "error", err)
 		rest.RenderError(r.Context(), w, errors.New("invalid room type id"))
+		return
 	}
 
 	var payload UpdateRoomTypeRequest
@@ -156,12 +233,15 @@ func (c *RoomTypeController) handlePartialUpdateHotelRoomType(w http.ResponseWri
 		return
 	}
 	if err := c.validator.Struct(payload); err != nil {
+		metrics.RecordValidationFailure(entityType)
 		c.log.Errorw("validation error", "error", err)
 		rest.RenderError(r.Context(), w, err)
 		return
 	}
 
 	hotel, err := c.roomTypeService.UpdatePartiallyRoomType(
+		r.Context(),
+		core.ActorFromContext(r.Context()),
 		uuidRoomTypeID,
 		uuidHotelID,
 		payload.Name,
@@ -182,5 +262,82 @@ func (c *RoomTypeController) handlePartialUpdateHotelRoomType(w http.ResponseWri
 }
 
 func (c *RoomTypeController) handleDeleteHotelRoomType(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("invalid hotel id"))
+		return
+	}
 
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomTypeNotFound)
+		return
+	}
+
+	err = c.roomTypeService.DeleteRoomType(r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRoomTypeID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *RoomTypeController) handleRestoreHotelRoomType(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, errors.New("invalid hotel id"))
+		return
+	}
+
+	roomTypeID := chi.URLParam(r, "room_type_id")
+	uuidRoomTypeID, err := uuid.FromString(roomTypeID)
+	if err != nil {
+		c.log.Errorw("invalid room type id", "roomTypeID", roomTypeID, "error", err)
+		rest.RenderError(r.Context(), w, ErrRoomTypeNotFound)
+		return
+	}
+
+	roomType, err := c.roomTypeService.RestoreRoomType(
+		r.Context(), core.ActorFromContext(r.Context()), uuidHotelID, uuidRoomTypeID,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewRoomTypeResponse(roomType)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+// convertPrices Converts the base prices of the responses into the currency
+// requested through the currency query parameter, if any
+func (c *RoomTypeController) convertPrices(r *http.Request, responses []RoomTypeResponse) error {
+	target := r.URL.Query().Get("currency")
+	if target == "" {
+		return nil
+	}
+
+	converters := make(map[string]currency.Converter)
+	for i, resp := range responses {
+		converter, ok := converters[resp.Currency]
+		if !ok {
+			var err error
+			converter, err = c.currencyService.Converter(r.Context(), resp.Currency, target)
+			if err != nil {
+				return err
+			}
+			converters[resp.Currency] = converter
+		}
+		responses[i].BasePrice = converter.Convert(resp.BasePrice)
+		responses[i].Currency = converter.To
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package roomtype
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -28,10 +29,43 @@ type RoomType struct {
 	BedType       string          `bun:"bed_type"`
 	MaxOccupancy  int             `bun:"max_occupancy"`
 	BasePrice     decimal.Decimal `bun:"base_price"`
+	Currency      string          `bun:"currency"`
+	DeletedAt     time.Time       `bun:"deleted_at,soft_delete,nullzero"`
 }
 
 type RoomTypes []RoomType
 
+// RoomTypeFilters narrows down room type listings, empty fields are ignored
+type RoomTypeFilters struct {
+	BedType        string
+	MinPrice       *decimal.Decimal
+	MaxPrice       *decimal.Decimal
+	MinOccupancy   int
+	IncludeDeleted bool
+}
+
+// SortableColumns public sort keys of room type listings and their columns
+var SortableColumns = map[string]string{
+	"name":          "name",
+	"base_price":    "base_price",
+	"max_occupancy": "max_occupancy",
+	"created_at":    "created_at",
+}
+
+const DefaultSort = "created_at"
+
+func cursorKey(rt RoomType, column string) (string, uuid.UUID) {
+	switch column {
+	case "name":
+		return rt.Name, rt.ID
+	case "base_price":
+		return rt.BasePrice.String(), rt.ID
+	case "max_occupancy":
+		return strconv.Itoa(rt.MaxOccupancy), rt.ID
+	}
+	return rt.CreatedAt.Format(time.RFC3339Nano), rt.ID
+}
+
 func NewRoomType(
 	hotelID uuid.UUID,
 	name string,
@@ -4,6 +4,11 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/sebenitezg/hotel-service/internal/audit"
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/outbox"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+
 	"github.com/gofrs/uuid/v5"
 	"github.com/uptrace/bun"
 )
@@ -18,55 +23,129 @@ func NewRepository(db *bun.DB) *RoomTypeRepository {
 	}
 }
 
-func (r *RoomTypeRepository) Save(roomType *RoomType) error {
-	_, err := r.db.NewInsert().
-		Model(roomType).
-		Exec(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Save Creates the room type and stores its audit record and the events in
+// the outbox within the same transaction
+func (r *RoomTypeRepository) Save(
+	ctx context.Context, roomType *RoomType, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(roomType).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
 }
 
-func (r *RoomTypeRepository) Update(roomType *RoomType) error {
-	_, err := r.db.NewUpdate().
-		Model(roomType).
-		Where("id = ?", roomType.ID).
-		Exec(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Update Saves the room type changes and stores their audit record and the
+// events in the outbox within the same transaction
+func (r *RoomTypeRepository) Update(
+	ctx context.Context, roomType *RoomType, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model(roomType).
+			Where("id = ?", roomType.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
 }
 
-func (r *RoomTypeRepository) Delete(id int64) error {
-	_, err := r.db.NewDelete().
-		Model((*RoomType)(nil)).
-		Where("id = ?", id).
-		Exec(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Delete Soft deletes the room type and stores the audit record and the
+// events in the outbox within the same transaction
+func (r *RoomTypeRepository) Delete(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*RoomType)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// Restore Undoes the soft deletion of the room type and stores the audit
+// record and the events in the outbox within the same transaction
+func (r *RoomTypeRepository) Restore(
+	ctx context.Context, id uuid.UUID, record core.AuditRecord, events ...core.DomainEvent,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*RoomType)(nil)).
+			Set("deleted_at = NULL").
+			Where("id = ?", id).
+			WhereDeleted().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := audit.Append(ctx, tx, record); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, events...)
+	})
+}
+
+// CountRooms Counts the live rooms of the given room type
+func (r *RoomTypeRepository) CountRooms(ctx context.Context, id uuid.UUID) (int, error) {
+	return r.db.NewSelect().
+		TableExpr("rooms").
+		Where("room_type_id = ? AND deleted_at IS NULL", id).
+		Count(ctx)
 }
 
-func (r *RoomTypeRepository) GetAll() ([]RoomType, error) {
+func (r *RoomTypeRepository) GetAll(ctx context.Context) ([]RoomType, error) {
 	var rooms []RoomType
 	err := r.db.NewSelect().
 		Model(&rooms).
-		Scan(context.Background())
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return rooms, nil
 }
 
-func (r *RoomTypeRepository) GetByID(id uuid.UUID) (*RoomType, error) {
+func (r *RoomTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*RoomType, error) {
+	var roomType RoomType
+	err := r.db.NewSelect().
+		Model(&roomType).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &roomType, nil
+}
+
+// GetByIDWithDeleted Same as GetByID but also finds soft deleted room types
+func (r *RoomTypeRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*RoomType, error) {
 	var roomType RoomType
 	err := r.db.NewSelect().
 		Model(&roomType).
 		Where("id = ?", id).
-		Scan(context.Background())
+		WhereAllWithDeleted().
+		Scan(ctx)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -76,27 +155,50 @@ func (r *RoomTypeRepository) GetByID(id uuid.UUID) (*RoomType, error) {
 	return &roomType, nil
 }
 
-func (r *RoomTypeRepository) GetByHotelRoomID(hotelID, roomTypeID uuid.UUID) (*RoomType, error) {
+func (r *RoomTypeRepository) GetByHotelRoomID(ctx context.Context, hotelID, roomTypeID uuid.UUID) (*RoomType, error) {
 	var roomType RoomType
 	err := r.db.NewSelect().
 		Model(&roomType).
 		Where("hotel_id = ? and id = ?", hotelID, roomTypeID).
-		Scan(context.Background())
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return &roomType, nil
 }
 
-func (r *RoomTypeRepository) GetByHotelID(hotelID uuid.UUID) (RoomTypes, error) {
+// GetByHotelID Returns a page of the hotel's room types matching the
+// filters along with the cursor of the next page
+func (r *RoomTypeRepository) GetByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters RoomTypeFilters, page pagination.Params,
+) (RoomTypes, string, error) {
 	var rooms RoomTypes
-	err := r.db.NewSelect().
+	q := r.db.NewSelect().
 		Model(&rooms).
-		Where("hotel_id = ?", hotelID).
-		Scan(context.Background())
+		Where("hotel_id = ?", hotelID)
+
+	if filters.IncludeDeleted {
+		q = q.WhereAllWithDeleted()
+	}
+	if filters.BedType != "" {
+		q = q.Where("bed_type = ?", filters.BedType)
+	}
+	if filters.MinPrice != nil {
+		q = q.Where("base_price >= ?", *filters.MinPrice)
+	}
+	if filters.MaxPrice != nil {
+		q = q.Where("base_price <= ?", *filters.MaxPrice)
+	}
+	if filters.MinOccupancy > 0 {
+		q = q.Where("max_occupancy >= ?", filters.MinOccupancy)
+	}
+
+	err := page.Apply(q).Scan(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return rooms, nil
+	rooms, nextCursor := pagination.Paginate(rooms, page, cursorKey)
+
+	return rooms, nextCursor, nil
 }
@@ -1,95 +1,140 @@
 package roomtype
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/sebenitezg/hotel-service/internal/core"
 	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/pagination"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
-	"go.uber.org/zap"
 )
 
+// entityType entity type of the room types in the audit log and domain
+// events
+const entityType = "room_type"
+
 type RoomTypeService struct {
-	roomTypeRepo   *RoomTypeRepository
-	hotelValidator core.HotelValidator
-	log            *zap.SugaredLogger
+	roomTypeRepo     *RoomTypeRepository
+	hotelValidator   core.HotelValidator
+	currencyResolver core.HotelCurrencyResolver
 }
 
 func NewService(
 	roomTypeRepo *RoomTypeRepository,
 	hotelValidator core.HotelValidator,
+	currencyResolver core.HotelCurrencyResolver,
 ) *RoomTypeService {
 	return &RoomTypeService{
-		roomTypeRepo:   roomTypeRepo,
-		hotelValidator: hotelValidator,
-		log:            logger.GetLogger(),
+		roomTypeRepo:     roomTypeRepo,
+		hotelValidator:   hotelValidator,
+		currencyResolver: currencyResolver,
 	}
 }
 
-func (s *RoomTypeService) ListRoomTypesByHotelID(hotelID uuid.UUID) (RoomTypes, error) {
-	rooms, err := s.roomTypeRepo.GetByHotelID(hotelID)
+func (s *RoomTypeService) ListRoomTypesByHotelID(
+	ctx context.Context, hotelID uuid.UUID, filters RoomTypeFilters, page pagination.Params,
+) (RoomTypes, string, error) {
+	ctx, span := tracing.Start(ctx, "RoomTypeService.ListRoomTypesByHotelID")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	rooms, nextCursor, err := s.roomTypeRepo.GetByHotelID(ctx, hotelID, filters, page)
 	if err != nil {
-		s.log.Errorw(
+		log.Errorw(
 			"error retrieving room types by hotel ID",
 			"hotelID", hotelID, "error", err,
 		)
-		return nil, err
+		return nil, "", tracing.Fail(span, err)
 	}
-	return rooms, nil
+	return rooms, nextCursor, nil
 }
 
+// RetrieveRoomTypeByHotelRoomTypeID Returns the hotel's room type, soft
+// deleted ones are only found when includeDeleted is set
 func (s *RoomTypeService) RetrieveRoomTypeByHotelRoomTypeID(
-	hotelID uuid.UUID, roomTypeID uuid.UUID,
+	ctx context.Context, hotelID uuid.UUID, roomTypeID uuid.UUID, includeDeleted bool,
 ) (*RoomType, error) {
-	roomType, err := s.roomTypeRepo.GetByID(roomTypeID)
+	ctx, span := tracing.Start(ctx, "RoomTypeService.RetrieveRoomTypeByHotelRoomTypeID")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	var (
+		roomType *RoomType
+		err      error
+	)
+	if includeDeleted {
+		roomType, err = s.roomTypeRepo.GetByIDWithDeleted(ctx, roomTypeID)
+	} else {
+		roomType, err = s.roomTypeRepo.GetByID(ctx, roomTypeID)
+	}
 	if err != nil {
-		return nil, err
+		return nil, tracing.Fail(span, err)
 	}
 
 	if roomType == nil {
-		s.log.Error("room type not found", "roomTypeID", roomTypeID)
-		return nil, ErrRoomTypeNotFound
+		log.Error("room type not found", "roomTypeID", roomTypeID)
+		return nil, tracing.Fail(span, ErrRoomTypeNotFound)
 	}
 
 	if roomType.HotelID != hotelID {
-		s.log.Errorw(
+		log.Errorw(
 			"error retrieving the room by hotel and room type IDs",
 			"hotelID", hotelID, "roomTypeID", roomTypeID, "error", err,
 		)
-		return nil, errors.New("hotel doesn't have any room type with the provided identifier")
+		return nil, tracing.Fail(span, ErrRoomTypeNotFound)
 	}
 
 	return roomType, nil
 }
 
-func (s *RoomTypeService) CreateRoomType(r *RoomType) (*RoomType, error) {
+func (s *RoomTypeService) CreateRoomType(ctx context.Context, actor core.Actor, r *RoomType) (*RoomType, error) {
+	ctx, span := tracing.Start(ctx, "RoomTypeService.CreateRoomType")
+	defer span.End()
+	log := logger.WithContext(ctx)
 
-	hotelExist, err := s.hotelValidator.ValidateHotelExists(r.HotelID)
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, r.HotelID)
 	if err != nil {
-		s.log.Errorw(
+		log.Errorw(
 			"error validating hotel existence",
 			"hotelID", r.HotelID, "error", err,
 		)
-		return nil, err
+		return nil, tracing.Fail(span, err)
 	}
 	if !hotelExist {
-		s.log.Errorw("hotel does not exist", "hotelID", r.HotelID)
-		return nil, errors.New("hotel does not exist")
+		log.Errorw("hotel does not exist", "hotelID", r.HotelID)
+		return nil, tracing.Fail(span, errors.New("hotel does not exist"))
+	}
+
+	// Base prices are denominated in the currency of the hotel
+	r.Currency, err = s.currencyResolver.ResolveHotelCurrency(ctx, r.HotelID)
+	if err != nil {
+		log.Errorw("error resolving hotel currency", "hotelID", r.HotelID, "error", err)
+		return nil, tracing.Fail(span, err)
 	}
 
-	if err := s.roomTypeRepo.Save(r); err != nil {
-		s.log.Errorw("error creating new room", "error", err)
-		return nil, err
+	record := newAuditRecord(actor, core.ActionCreate, r, nil, NewRoomTypeResponse(r))
+	if err := s.roomTypeRepo.Save(ctx, r, record, newEvent(core.RoomTypeCreated, r)); err != nil {
+		log.Errorw("error creating new room", "error", err)
+		return nil, tracing.Fail(span, err)
 	}
+	metrics.RecordChange(entityType, core.ActionCreate)
 
-	s.log.Infow("room created successfully", "hotelID", r.ID, "roomTypeID", r.ID)
+	log.Infow("room created successfully", "hotelID", r.ID, "roomTypeID", r.ID)
 
 	return r, nil
 }
 
 func (s *RoomTypeService) UpdatePartiallyRoomType(
+	ctx context.Context,
+	actor core.Actor,
 	roomTypeID uuid.UUID,
 	uuidHotelID uuid.UUID,
 	name *string,
@@ -99,27 +144,33 @@ func (s *RoomTypeService) UpdatePartiallyRoomType(
 	maxOccupancy *int,
 	basePrice *decimal.Decimal,
 ) (*RoomType, error) {
-	roomType, err := s.roomTypeRepo.GetByID(roomTypeID)
+	ctx, span := tracing.Start(ctx, "RoomTypeService.UpdatePartiallyRoomType")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	roomType, err := s.roomTypeRepo.GetByID(ctx, roomTypeID)
 	if err != nil {
-		s.log.Errorw(
+		log.Errorw(
 			"failure geting RoomType entity to update partially it",
 			"roomTypeID", roomTypeID, "error", err,
 		)
-		return nil, err
+		return nil, tracing.Fail(span, err)
 	}
 	if roomType == nil {
-		s.log.Errorw("room type entity not found", "roomTypeID", roomTypeID)
-		return nil, ErrRoomTypeNotFound
+		log.Errorw("room type entity not found", "roomTypeID", roomTypeID)
+		return nil, tracing.Fail(span, ErrRoomTypeNotFound)
 	}
 
 	if roomType.HotelID != uuidHotelID {
-		s.log.Errorw(
+		log.Errorw(
 			"hotel does not have the room with the provided ID",
 			"hotelID", uuidHotelID, "roomTypeID", roomTypeID,
 		)
-		return nil, errors.New("hotel does not have the room with the provided ID")
+		return nil, tracing.Fail(span, errors.New("hotel does not have the room with the provided ID"))
 	}
 
+	before := NewRoomTypeResponse(roomType)
+
 	if name != nil {
 		roomType.Name = *name
 	}
@@ -139,22 +190,134 @@ func (s *RoomTypeService) UpdatePartiallyRoomType(
 		roomType.BasePrice = *basePrice
 	}
 
-	err = s.roomTypeRepo.Update(roomType)
+	record := newAuditRecord(actor, core.ActionUpdate, roomType, before, NewRoomTypeResponse(roomType))
+	err = s.roomTypeRepo.Update(ctx, roomType, record, newEvent(core.RoomTypeUpdated, roomType))
 	if err != nil {
-		s.log.Errorw(
+		log.Errorw(
 			"failure updating partially room",
 			"roomTypeID", roomTypeID, "error", err,
 		)
-		return nil, err
+		return nil, tracing.Fail(span, err)
 	}
+	metrics.RecordChange(entityType, core.ActionUpdate)
 
 	return roomType, nil
 }
 
-func (s RoomTypeService) ValidateRoomTypeExists(id uuid.UUID) (bool, error) {
-	roomType, err := s.roomTypeRepo.GetByID(id)
+// DeleteRoomType Soft deletes a room type no longer referenced by any room
+func (s *RoomTypeService) DeleteRoomType(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, roomTypeID uuid.UUID,
+) error {
+	ctx, span := tracing.Start(ctx, "RoomTypeService.DeleteRoomType")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	roomType, err := s.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, false)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	rooms, err := s.roomTypeRepo.CountRooms(ctx, roomType.ID)
+	if err != nil {
+		log.Errorw("failure counting room type rooms", "roomTypeID", roomTypeID, "error", err)
+		return tracing.Fail(span, err)
+	}
+	if rooms > 0 {
+		return tracing.Fail(span, core.Conflict(
+			"room_type_in_use",
+			fmt.Sprintf("room type is still referenced by %d rooms", rooms),
+			map[string]string{"rooms": strconv.Itoa(rooms)},
+		))
+	}
+
+	record := newAuditRecord(actor, core.ActionDelete, roomType, NewRoomTypeResponse(roomType), nil)
+	if err := s.roomTypeRepo.Delete(ctx, roomType.ID, record, newEvent(core.RoomTypeDeleted, roomType)); err != nil {
+		log.Errorw("failure deleting room type", "roomTypeID", roomTypeID, "error", err)
+		return tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionDelete)
+
+	log.Infow("room type deleted successfully", "hotelID", hotelID, "roomTypeID", roomTypeID)
+
+	return nil
+}
+
+// RestoreRoomType Undoes the soft deletion of a room type whose hotel is
+// not deleted
+func (s *RoomTypeService) RestoreRoomType(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, roomTypeID uuid.UUID,
+) (*RoomType, error) {
+	ctx, span := tracing.Start(ctx, "RoomTypeService.RestoreRoomType")
+	defer span.End()
+	log := logger.WithContext(ctx)
+
+	roomType, err := s.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, roomTypeID, true)
 	if err != nil {
-		return false, err
+		return nil, tracing.Fail(span, err)
+	}
+	if roomType.DeletedAt.IsZero() {
+		return nil, tracing.Fail(span, ErrRoomTypeNotDeleted)
+	}
+
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	if !hotelExist {
+		return nil, tracing.Fail(span, ErrRoomTypeHotelGone)
+	}
+
+	before := NewRoomTypeResponse(roomType)
+	roomType.DeletedAt = time.Time{}
+
+	record := newAuditRecord(actor, core.ActionRestore, roomType, before, NewRoomTypeResponse(roomType))
+	if err := s.roomTypeRepo.Restore(ctx, roomType.ID, record, newEvent(core.RoomTypeRestored, roomType)); err != nil {
+		log.Errorw("failure restoring room type", "roomTypeID", roomTypeID, "error", err)
+		return nil, tracing.Fail(span, err)
+	}
+	metrics.RecordChange(entityType, core.ActionRestore)
+
+	log.Infow("room type restored successfully", "hotelID", hotelID, "roomTypeID", roomTypeID)
+
+	return roomType, nil
+}
+
+// ValidateHotelRoomTypeExists Reports whether the room type exists and
+// belongs to the hotel
+func (s RoomTypeService) ValidateHotelRoomTypeExists(ctx context.Context, hotelID, roomTypeID uuid.UUID) (bool, error) {
+	ctx, span := tracing.Start(ctx, "RoomTypeService.ValidateHotelRoomTypeExists")
+	defer span.End()
+
+	roomType, err := s.roomTypeRepo.GetByID(ctx, roomTypeID)
+	if err != nil {
+		return false, tracing.Fail(span, err)
+	}
+	return roomType != nil && roomType.HotelID == hotelID, nil
+}
+
+// newAuditRecord Audit record of a change made to the room type by the actor
+func newAuditRecord(actor core.Actor, action string, roomType *RoomType, before, after any) core.AuditRecord {
+	return core.AuditRecord{
+		Actor: actor,
+		Change: core.Change{
+			HotelID:    roomType.HotelID,
+			EntityType: entityType,
+			EntityID:   roomType.ID,
+			Action:     action,
+			Before:     before,
+			After:      after,
+		},
+	}
+}
+
+// newEvent Domain event of a change made to the room type
+func newEvent(eventType string, roomType *RoomType) core.DomainEvent {
+	return core.DomainEvent{
+		Type:          eventType,
+		HotelID:       roomType.HotelID,
+		AggregateType: entityType,
+		AggregateID:   roomType.ID,
+		Payload:       NewRoomTypeResponse(roomType),
 	}
-	return roomType != nil, nil
 }
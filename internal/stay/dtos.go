@@ -0,0 +1,98 @@
+package stay
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type CheckInRequest struct {
+	RoomID           uuid.UUID  `json:"room_id" validate:"required"`
+	ReservationID    *uuid.UUID `json:"reservation_id"`
+	GuestID          *uuid.UUID `json:"guest_id"`
+	GuestName        string     `json:"guest_name" validate:"max=128"`
+	Guests           int        `json:"guests" validate:"required,min=1"`
+	ExpectedCheckOut string     `json:"expected_check_out" validate:"required,datetime=2006-01-02"`
+}
+
+type MoveRoomRequest struct {
+	RoomID uuid.UUID `json:"room_id" validate:"required"`
+	Reason string    `json:"reason" validate:"max=512"`
+}
+
+type RoomMoveResponse struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  string    `json:"created_at"`
+	FromRoomID uuid.UUID `json:"from_room_id"`
+	ToRoomID   uuid.UUID `json:"to_room_id"`
+	Actor      string    `json:"actor"`
+	Reason     string    `json:"reason,omitempty"`
+}
+
+type StayResponse struct {
+	ID               uuid.UUID          `json:"id"`
+	CreatedAt        string             `json:"created_at"`
+	UpdatedAt        string             `json:"updated_at"`
+	HotelID          uuid.UUID          `json:"hotel_id"`
+	RoomID           uuid.UUID          `json:"room_id"`
+	Floor            int                `json:"floor"`
+	RoomNumber       int                `json:"room_number"`
+	ReservationID    *uuid.UUID         `json:"reservation_id,omitempty"`
+	GuestID          *uuid.UUID         `json:"guest_id,omitempty"`
+	GuestName        string             `json:"guest_name"`
+	Guests           int                `json:"guests"`
+	ExpectedCheckOut string             `json:"expected_check_out"`
+	Status           string             `json:"status"`
+	CheckedInAt      string             `json:"checked_in_at"`
+	CheckedInBy      string             `json:"checked_in_by"`
+	CheckedOutAt     string             `json:"checked_out_at,omitempty"`
+	CheckedOutBy     string             `json:"checked_out_by,omitempty"`
+	Moves            []RoomMoveResponse `json:"moves,omitempty"`
+}
+
+type ListStaysResponse struct {
+	Results []StayResponse `json:"results"`
+}
+
+func NewStayResponse(s *Stay) StayResponse {
+	resp := StayResponse{
+		ID:               s.ID,
+		CreatedAt:        s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        s.UpdatedAt.Format(time.RFC3339),
+		HotelID:          s.HotelID,
+		RoomID:           s.RoomID,
+		Floor:            s.Floor,
+		RoomNumber:       s.RoomNumber,
+		ReservationID:    s.ReservationID,
+		GuestID:          s.GuestID,
+		GuestName:        s.GuestName,
+		Guests:           s.Guests,
+		ExpectedCheckOut: s.ExpectedCheckOut.Format(time.DateOnly),
+		Status:           s.Status,
+		CheckedInAt:      s.CheckedInAt.Format(time.RFC3339),
+		CheckedInBy:      s.CheckedInBy,
+		CheckedOutBy:     s.CheckedOutBy,
+	}
+	if !s.CheckedOutAt.IsZero() {
+		resp.CheckedOutAt = s.CheckedOutAt.Format(time.RFC3339)
+	}
+	for _, move := range s.Moves {
+		resp.Moves = append(resp.Moves, RoomMoveResponse{
+			ID:         move.ID,
+			CreatedAt:  move.CreatedAt.Format(time.RFC3339),
+			FromRoomID: move.FromRoomID,
+			ToRoomID:   move.ToRoomID,
+			Actor:      move.Actor,
+			Reason:     move.Reason,
+		})
+	}
+	return resp
+}
+
+func NewListStaysResponse(stays Stays) ListStaysResponse {
+	responses := make([]StayResponse, len(stays))
+	for i, stay := range stays {
+		responses[i] = NewStayResponse(&stay)
+	}
+	return ListStaysResponse{Results: responses}
+}
@@ -0,0 +1,48 @@
+package stay
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound       = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrStayNotFound        = terrors.NotFound("stay", "stay not found", nil)
+	ErrInvalidCheckOut     = terrors.BadRequest("expected_check_out", "expected_check_out must be after today", nil)
+	ErrReservationNotValid = core.Conflict("reservation_not_valid", "cancelled reservations can not be checked in", nil)
+	ErrRoomOccupied        = core.Conflict("room_occupied", "room already has a stay in house", nil)
+	ErrStayNotInHouse      = core.Conflict("stay_not_in_house", "stay is already checked out", nil)
+	ErrSameRoom            = terrors.BadRequest("room_id", "the stay is already in that room", nil)
+	ErrRoomReserved        = core.Conflict("room_reserved", "room is reserved for some of the nights of the stay", nil)
+	ErrReservationInHouse  = core.Conflict("reservation_in_house", "reservation already has a stay in house", nil)
+	ErrReservationRoom     = terrors.BadRequest("room_id", "room_id must be the room of the reservation", nil)
+	ErrReservationNotToday = terrors.PreconditionFailed(
+		"reservation_dates", "reservations are checked in from their check_in until the day before check_out", nil,
+	)
+	ErrMissingGuestName = terrors.BadRequest(
+		"guest_name", "guest_name is required when checking in without a reservation or guest profile", nil,
+	)
+)
+
+// newRoomNotAvailableError Error of a room whose status does not allow
+// checking a party into it
+func newRoomNotAvailableError(status string) error {
+	return core.Conflict(
+		"room_not_available",
+		fmt.Sprintf("room is %s, only available rooms can be checked into", status),
+		map[string]string{"status": status},
+	)
+}
+
+// newPartyTooLargeError Error of a party larger than the room's occupancy
+func newPartyTooLargeError(guests int, maxOccupancy int) error {
+	return terrors.BadRequest(
+		"guests",
+		fmt.Sprintf("the room hosts at most %d guests, the party has %d", maxOccupancy, guests),
+		map[string]string{"max_occupancy": strconv.Itoa(maxOccupancy)},
+	)
+}
@@ -0,0 +1,218 @@
+package stay
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type StayController struct {
+	validator   *validator.Validate
+	stayService *StayService
+	log         *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	stayService *StayService,
+	membershipChecker auth.HotelMembershipChecker,
+) *StayController {
+	c := &StayController{
+		validator:   validator,
+		stayService: stayService,
+		log:         logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleReservationRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/in-house", c.handleListInHouse)
+		r.Get("/v1/hotels/{hotel_id}/stays/{stay_id}", c.handleGetStay)
+	})
+
+	// Front desk agents run check-ins and check-outs
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleReservationWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Post("/v1/hotels/{hotel_id}/stays", c.handleCheckIn)
+		r.Post("/v1/hotels/{hotel_id}/stays/{stay_id}/check-out", c.handleCheckOut)
+		r.Post("/v1/hotels/{hotel_id}/stays/{stay_id}/move", c.handleMoveRoom)
+	})
+
+	return c
+}
+
+func (c *StayController) handleListInHouse(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	stays, err := c.stayService.ListInHouse(r.Context(), hotelID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListStaysResponse(stays)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *StayController) handleGetStay(w http.ResponseWriter, r *http.Request) {
+	hotelID, stayID, ok := c.hotelStayIDs(w, r)
+	if !ok {
+		return
+	}
+
+	stay, err := c.stayService.RetrieveStay(r.Context(), hotelID, stayID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewStayResponse(stay)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *StayController) handleCheckIn(w http.ResponseWriter, r *http.Request) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload CheckInRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	// The date was already validated against the layout
+	expectedCheckOut, _ := time.Parse(time.DateOnly, payload.ExpectedCheckOut)
+
+	actor := core.ActorFromContext(r.Context())
+
+	stay, err := NewStay(
+		hotelID,
+		payload.RoomID,
+		payload.ReservationID,
+		payload.GuestID,
+		payload.GuestName,
+		payload.Guests,
+		expectedCheckOut,
+		actor.Principal,
+	)
+	if err != nil {
+		c.log.Errorw("failure creating stay instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	stay, err = c.stayService.CheckIn(r.Context(), actor, stay)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewStayResponse(stay)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *StayController) handleCheckOut(w http.ResponseWriter, r *http.Request) {
+	hotelID, stayID, ok := c.hotelStayIDs(w, r)
+	if !ok {
+		return
+	}
+
+	stay, err := c.stayService.CheckOut(r.Context(), core.ActorFromContext(r.Context()), hotelID, stayID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewStayResponse(stay)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *StayController) handleMoveRoom(w http.ResponseWriter, r *http.Request) {
+	hotelID, stayID, ok := c.hotelStayIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var payload MoveRoomRequest
+	if !c.decode(w, r, &payload) {
+		return
+	}
+
+	stay, err := c.stayService.MoveRoom(
+		r.Context(),
+		core.ActorFromContext(r.Context()), hotelID, stayID, payload.RoomID, payload.Reason,
+	)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewStayResponse(stay)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *StayController) hotelID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return uuid.Nil, false
+	}
+	return uuidHotelID, true
+}
+
+func (c *StayController) hotelStayIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	hotelID, ok := c.hotelID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	stayID := chi.URLParam(r, "stay_id")
+	uuidStayID, err := uuid.FromString(stayID)
+	if err != nil {
+		c.log.Errorw("invalid stay id", "stayID", stayID, "error", err)
+		rest.RenderError(r.Context(), w, ErrStayNotFound)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return hotelID, uuidStayID, true
+}
+
+// decode Reads and validates the request body into payload
+func (c *StayController) decode(w http.ResponseWriter, r *http.Request, payload any) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return false
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return false
+	}
+	return true
+}
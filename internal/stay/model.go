@@ -0,0 +1,114 @@
+package stay
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type Status string
+
+const (
+	IN_HOUSE    Status = "in_house"
+	CHECKED_OUT Status = "checked_out"
+)
+
+// --------------------
+// DB models
+// --------------------
+
+// Stay occupancy of a room of the hotel by a party, from its check-in until
+// its check-out. A room has at most one stay in house.
+type Stay struct {
+	bun.BaseModel    `bun:"table:stays,alias:s"`
+	ID               uuid.UUID  `bun:"id,pk"`
+	CreatedAt        time.Time  `bun:"created_at"`
+	UpdatedAt        time.Time  `bun:"updated_at"`
+	HotelID          uuid.UUID  `bun:"hotel_id"`
+	RoomID           uuid.UUID  `bun:"room_id"`
+	ReservationID    *uuid.UUID `bun:"reservation_id"`
+	GuestID          *uuid.UUID `bun:"guest_id"`
+	Guests           int        `bun:"guests"`
+	ExpectedCheckOut time.Time  `bun:"expected_check_out,type:date"`
+	Status           string     `bun:"status"`
+	CheckedInAt      time.Time  `bun:"checked_in_at"`
+	CheckedInBy      string     `bun:"checked_in_by"`
+	CheckedOutAt     time.Time  `bun:"checked_out_at,nullzero"`
+	CheckedOutBy     string     `bun:"checked_out_by"`
+	// Floor and RoomNumber are read from the stay's room
+	Floor      int `bun:"floor,scanonly"`
+	RoomNumber int `bun:"room_number,scanonly"`
+	// GuestName is read from the guest profile, it is not stored
+	GuestName string `bun:"-"`
+
+	Moves RoomMoves `bun:"rel:has-many,join:id=stay_id"`
+}
+
+type Stays []Stay
+
+// RoomMove change of room of a stay, kept as its room history
+type RoomMove struct {
+	bun.BaseModel `bun:"table:stay_room_moves"`
+	ID            uuid.UUID `bun:"id,pk"`
+	CreatedAt     time.Time `bun:"created_at"`
+	StayID        uuid.UUID `bun:"stay_id"`
+	FromRoomID    uuid.UUID `bun:"from_room_id"`
+	ToRoomID      uuid.UUID `bun:"to_room_id"`
+	Actor         string    `bun:"actor"`
+	Reason        string    `bun:"reason"`
+}
+
+type RoomMoves []RoomMove
+
+func NewStay(
+	hotelID uuid.UUID,
+	roomID uuid.UUID,
+	reservationID *uuid.UUID,
+	guestID *uuid.UUID,
+	guestName string,
+	guests int,
+	expectedCheckOut time.Time,
+	checkedInBy string,
+) (*Stay, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Stay{
+		ID:               id,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		HotelID:          hotelID,
+		RoomID:           roomID,
+		ReservationID:    reservationID,
+		GuestID:          guestID,
+		GuestName:        guestName,
+		Guests:           guests,
+		ExpectedCheckOut: expectedCheckOut,
+		Status:           string(IN_HOUSE),
+		CheckedInAt:      now,
+		CheckedInBy:      checkedInBy,
+	}, nil
+}
+
+// NewRoomMove Records the stay moving from its current room into toRoomID
+func NewRoomMove(stay *Stay, toRoomID uuid.UUID, actor string, reason string) (*RoomMove, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoomMove{
+		ID:         id,
+		CreatedAt:  time.Now().UTC(),
+		StayID:     stay.ID,
+		FromRoomID: stay.RoomID,
+		ToRoomID:   toRoomID,
+		Actor:      actor,
+		Reason:     reason,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package stay
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/room"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+// uniqueViolation is the Postgres error code raised by the
+// stays_room_in_house_idx and stays_reservation_in_house_idx indexes.
+const uniqueViolation = "23505"
+
+// reservationInHouseIndex index allowing a single stay in house per
+// reservation
+const reservationInHouseIndex = "stays_reservation_in_house_idx"
+
+type StayRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *StayRepository {
+	return &StayRepository{
+		db: db,
+	}
+}
+
+// CheckIn Creates the stay and moves its room into the occupied status
+// within the same transaction. Stays without a reservation are rejected when
+// the room is reserved for some of their nights.
+func (r *StayRepository) CheckIn(ctx context.Context, stay *Stay, occupy *room.StatusChange) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if stay.ReservationID == nil {
+			if err := checkNotReserved(ctx, tx, stay.RoomID, stay.ExpectedCheckOut); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.NewInsert().Model(stay).Exec(ctx); err != nil {
+			return inHouseError(err)
+		}
+		return room.ApplyStatusChange(ctx, tx, occupy)
+	})
+}
+
+// CheckOut Stores the check-out of the stay unless it is no longer in house,
+// and the room being vacated when there is one, within the same transaction
+func (r *StayRepository) CheckOut(ctx context.Context, stay *Stay, vacate *room.StatusChange) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(stay).
+			Column("updated_at", "status", "checked_out_at", "checked_out_by").
+			WherePK().
+			Where("status = ?", IN_HOUSE).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrStayNotInHouse
+		}
+
+		if vacate == nil {
+			return nil
+		}
+		return room.ApplyStatusChange(ctx, tx, vacate)
+	})
+}
+
+// Move Moves the stay into the room of the move, records it and stores the
+// new room being occupied and the previous one being vacated, when there is
+// one, in the same transaction. It fails when the stay left its room, the
+// new room has another stay in house or, for stays without a reservation, is
+// reserved for some of their nights.
+func (r *StayRepository) Move(
+	ctx context.Context, stay *Stay, move *RoomMove, occupy *room.StatusChange, vacate *room.StatusChange,
+) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if stay.ReservationID == nil {
+			if err := checkNotReserved(ctx, tx, move.ToRoomID, stay.ExpectedCheckOut); err != nil {
+				return err
+			}
+		}
+
+		res, err := tx.NewUpdate().
+			Model((*Stay)(nil)).
+			Set("room_id = ?", move.ToRoomID).
+			Set("updated_at = ?", move.CreatedAt).
+			Where("id = ?", stay.ID).
+			Where("room_id = ?", move.FromRoomID).
+			Where("status = ?", IN_HOUSE).
+			Exec(ctx)
+		if err != nil {
+			return inHouseError(err)
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrStayNotInHouse
+		}
+
+		if _, err := tx.NewInsert().Model(move).Exec(ctx); err != nil {
+			return err
+		}
+		if err := room.ApplyStatusChange(ctx, tx, occupy); err != nil {
+			return err
+		}
+		if vacate == nil {
+			return nil
+		}
+		return room.ApplyStatusChange(ctx, tx, vacate)
+	})
+}
+
+// GetByID Returns the stay along with its room moves
+func (r *StayRepository) GetByID(ctx context.Context, id uuid.UUID) (*Stay, error) {
+	var stay Stay
+	err := r.selectStays(&stay).
+		Relation("Moves", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("created_at ASC")
+		}).
+		Where("s.id = ?", id).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stay, nil
+}
+
+// GetInHouse Returns the hotel's stays in house sorted by floor and room
+// number
+func (r *StayRepository) GetInHouse(ctx context.Context, hotelID uuid.UUID) (Stays, error) {
+	var stays Stays
+	err := r.selectStays(&stays).
+		Where("s.hotel_id = ?", hotelID).
+		Where("s.status = ?", IN_HOUSE).
+		Order("r.floor ASC", "r.number ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stays, nil
+}
+
+// selectStays Selects the stays along with the floor and number of their rooms
+func (r *StayRepository) selectStays(model any) *bun.SelectQuery {
+	return r.db.NewSelect().
+		Model(model).
+		ColumnExpr("s.*").
+		ColumnExpr("r.floor").
+		ColumnExpr("r.number AS room_number").
+		Join("JOIN rooms AS r ON r.id = s.room_id")
+}
+
+// checkNotReserved Rejects a stay in the room from today until checkOut
+// when the room has a reservation on some of those nights. The room is
+// locked so reservations created concurrently, which lock it in share mode,
+// wait for the stay.
+func checkNotReserved(ctx context.Context, db bun.IDB, roomID uuid.UUID, checkOut time.Time) error {
+	_, err := db.NewSelect().
+		TableExpr("rooms").
+		ColumnExpr("id").
+		Where("id = ?", roomID).
+		For("UPDATE").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	reserved, err := db.NewSelect().
+		TableExpr("reservations").
+		Where("room_id = ?", roomID).
+		Where("status <> 'cancelled'").
+		Where("daterange(check_in, check_out, '[)') && daterange(CURRENT_DATE, ?::date, '[)')", checkOut).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if reserved {
+		return ErrRoomReserved
+	}
+	return nil
+}
+
+// inHouseError Returns the conflict of a stay in house clashing with another
+// one of its room or reservation
+func inHouseError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
+		return err
+	}
+	if pgErr.ConstraintName == reservationInHouseIndex {
+		return ErrReservationInHouse
+	}
+	return ErrRoomOccupied
+}
@@ -0,0 +1,309 @@
+package stay
+
+import (
+	"context"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/internal/guest"
+	"github.com/sebenitezg/hotel-service/internal/reservation"
+	"github.com/sebenitezg/hotel-service/internal/room"
+	"github.com/sebenitezg/hotel-service/internal/roomtype"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type StayService struct {
+	stayRepo           *StayRepository
+	hotelValidator     core.HotelValidator
+	roomService        *room.RoomService
+	roomTypeService    *roomtype.RoomTypeService
+	reservationService *reservation.ReservationService
+	guestService       *guest.GuestService
+	log                *zap.SugaredLogger
+}
+
+func NewService(
+	stayRepo *StayRepository,
+	hotelValidator core.HotelValidator,
+	roomService *room.RoomService,
+	roomTypeService *roomtype.RoomTypeService,
+	reservationService *reservation.ReservationService,
+	guestService *guest.GuestService,
+) *StayService {
+	return &StayService{
+		stayRepo:           stayRepo,
+		hotelValidator:     hotelValidator,
+		roomService:        roomService,
+		roomTypeService:    roomTypeService,
+		reservationService: reservationService,
+		guestService:       guestService,
+		log:                logger.GetLogger(),
+	}
+}
+
+// CheckIn Checks the party of the stay into its room, which must be
+// available and host the whole party, and marks the room occupied in the
+// same transaction. Reservations are checked into their own room between
+// their check-in and check-out dates, at most once at a time. The stay is
+// linked to the guest profile given or to the one of the reservation,
+// otherwise a profile is created with the guest name.
+func (s *StayService) CheckIn(ctx context.Context, actor core.Actor, st *Stay) (*Stay, error) {
+	if !st.ExpectedCheckOut.After(time.Now().UTC()) {
+		return nil, ErrInvalidCheckOut
+	}
+
+	if st.ReservationID != nil {
+		res, err := s.reservationService.RetrieveReservationByHotelReservationID(ctx, st.HotelID, *st.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+		if res.Status == string(reservation.CANCELLED) {
+			return nil, ErrReservationNotValid
+		}
+		if res.RoomID != st.RoomID {
+			return nil, ErrReservationRoom
+		}
+		if today := time.Now().UTC().Truncate(24 * time.Hour); today.Before(res.CheckIn) || !today.Before(res.CheckOut) {
+			return nil, ErrReservationNotToday
+		}
+		if st.GuestID == nil && !res.GuestID.IsNil() {
+			st.GuestID = &res.GuestID
+		}
+	}
+	if st.GuestID == nil && st.GuestName == "" {
+		return nil, ErrMissingGuestName
+	}
+
+	if _, err := s.retrieveRoomToOccupy(ctx, st.HotelID, st.RoomID, st.Guests); err != nil {
+		return nil, err
+	}
+
+	if st.GuestID != nil {
+		g, err := s.guestService.RetrieveGuest(ctx, st.HotelID, *st.GuestID)
+		if err != nil {
+			return nil, err
+		}
+		st.GuestName = g.FullName()
+	} else {
+		guestID, err := s.guestService.FindOrCreateGuest(ctx, st.HotelID, st.GuestName, "")
+		if err != nil {
+			return nil, err
+		}
+		st.GuestID = &guestID
+	}
+
+	occupy, err := s.roomService.NewStatusChange(
+		ctx, actor, st.HotelID, st.RoomID, string(room.OCCUPIED), "guest checked in",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// A room or reservation already in house is rejected by the
+	// stays_room_in_house_idx and stays_reservation_in_house_idx indexes
+	if err := s.stayRepo.CheckIn(ctx, st, occupy); err != nil {
+		s.log.Errorw("error checking in stay", "roomID", st.RoomID, "error", err)
+		return nil, err
+	}
+	s.roomService.StatusChanged(ctx, occupy)
+
+	s.log.Infow("guest checked in successfully", "stayID", st.ID, "roomID", st.RoomID)
+
+	return s.RetrieveStay(ctx, st.HotelID, st.ID)
+}
+
+// CheckOut Closes the stay and marks its room dirty for housekeeping in the
+// same transaction
+func (s *StayService) CheckOut(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, stayID uuid.UUID,
+) (*Stay, error) {
+	st, err := s.RetrieveStay(ctx, hotelID, stayID)
+	if err != nil {
+		return nil, err
+	}
+	if st.Status != string(IN_HOUSE) {
+		return nil, ErrStayNotInHouse
+	}
+
+	vacate, err := s.newVacateChange(ctx, actor, hotelID, st.RoomID, "guest checked out")
+	if err != nil {
+		return nil, err
+	}
+
+	st.Status = string(CHECKED_OUT)
+	st.UpdatedAt = time.Now().UTC()
+	st.CheckedOutAt = st.UpdatedAt
+	st.CheckedOutBy = actor.Principal
+	if err := s.stayRepo.CheckOut(ctx, st, vacate); err != nil {
+		s.log.Errorw("failure checking out stay", "stayID", stayID, "error", err)
+		return nil, err
+	}
+	if vacate != nil {
+		s.roomService.StatusChanged(ctx, vacate)
+	}
+
+	s.log.Infow("guest checked out successfully", "stayID", stayID, "roomID", st.RoomID)
+
+	return st, nil
+}
+
+// MoveRoom Moves the stay into another available room of the hotel able to
+// host the party. The new room is marked occupied and the previous one
+// dirty in the same transaction, the move is kept in the stay's room
+// history.
+func (s *StayService) MoveRoom(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, stayID uuid.UUID, toRoomID uuid.UUID, reason string,
+) (*Stay, error) {
+	st, err := s.RetrieveStay(ctx, hotelID, stayID)
+	if err != nil {
+		return nil, err
+	}
+	if st.Status != string(IN_HOUSE) {
+		return nil, ErrStayNotInHouse
+	}
+	if st.RoomID == toRoomID {
+		return nil, ErrSameRoom
+	}
+
+	if _, err := s.retrieveRoomToOccupy(ctx, hotelID, toRoomID, st.Guests); err != nil {
+		return nil, err
+	}
+
+	occupy, err := s.roomService.NewStatusChange(ctx, actor, hotelID, toRoomID, string(room.OCCUPIED), "guest moved in")
+	if err != nil {
+		return nil, err
+	}
+	vacate, err := s.newVacateChange(ctx, actor, hotelID, st.RoomID, "guest moved out")
+	if err != nil {
+		return nil, err
+	}
+
+	move, err := NewRoomMove(st, toRoomID, actor.Principal, reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stayRepo.Move(ctx, st, move, occupy, vacate); err != nil {
+		s.log.Errorw("failure moving stay", "stayID", stayID, "error", err)
+		return nil, err
+	}
+	s.roomService.StatusChanged(ctx, occupy)
+	if vacate != nil {
+		s.roomService.StatusChanged(ctx, vacate)
+	}
+
+	s.log.Infow("stay moved successfully", "stayID", stayID, "from", move.FromRoomID, "to", toRoomID)
+
+	return s.RetrieveStay(ctx, hotelID, stayID)
+}
+
+// RetrieveStay Returns the hotel's stay along with its room moves
+func (s *StayService) RetrieveStay(ctx context.Context, hotelID uuid.UUID, stayID uuid.UUID) (*Stay, error) {
+	st, err := s.stayRepo.GetByID(ctx, stayID)
+	if err != nil {
+		s.log.Errorw("error retrieving stay", "stayID", stayID, "error", err)
+		return nil, err
+	}
+	if st == nil || st.HotelID != hotelID {
+		return nil, ErrStayNotFound
+	}
+
+	if err := s.resolveGuests(ctx, hotelID, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// ListInHouse Returns the stays in house of the hotel sorted by floor and
+// room number
+func (s *StayService) ListInHouse(ctx context.Context, hotelID uuid.UUID) (Stays, error) {
+	hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error validating hotel existence", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	if !hotelExist {
+		return nil, ErrHotelNotFound
+	}
+
+	stays, err := s.stayRepo.GetInHouse(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving in house stays", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+
+	guestStays := make([]*Stay, len(stays))
+	for i := range stays {
+		guestStays[i] = &stays[i]
+	}
+	if err := s.resolveGuests(ctx, hotelID, guestStays...); err != nil {
+		return nil, err
+	}
+	return stays, nil
+}
+
+// resolveGuests Reads the guest names of the hotel's stays from their guest
+// profiles
+func (s *StayService) resolveGuests(ctx context.Context, hotelID uuid.UUID, stays ...*Stay) error {
+	guestIDs := make([]uuid.UUID, 0, len(stays))
+	for _, st := range stays {
+		if st.GuestID != nil {
+			guestIDs = append(guestIDs, *st.GuestID)
+		}
+	}
+
+	contacts, err := s.guestService.ResolveGuestContacts(ctx, hotelID, guestIDs)
+	if err != nil {
+		return err
+	}
+	for _, st := range stays {
+		if st.GuestID != nil {
+			st.GuestName = contacts[*st.GuestID].Name
+		}
+	}
+	return nil
+}
+
+// retrieveRoomToOccupy Returns the hotel's room when it is available and its
+// room type hosts the party
+func (s *StayService) retrieveRoomToOccupy(
+	ctx context.Context, hotelID uuid.UUID, roomID uuid.UUID, guests int,
+) (*room.Room, error) {
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, false)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != string(room.AVAILABLE) {
+		return nil, newRoomNotAvailableError(r.Status)
+	}
+
+	rt, err := s.roomTypeService.RetrieveRoomTypeByHotelRoomTypeID(ctx, hotelID, r.RoomTypeID, false)
+	if err != nil {
+		return nil, err
+	}
+	if guests > rt.MaxOccupancy {
+		return nil, newPartyTooLargeError(guests, rt.MaxOccupancy)
+	}
+
+	return r, nil
+}
+
+// newVacateChange Returns the change marking the occupied room dirty, rooms
+// whose status was changed by the staff meanwhile are left as they are and
+// have none
+func (s *StayService) newVacateChange(
+	ctx context.Context, actor core.Actor, hotelID uuid.UUID, roomID uuid.UUID, reason string,
+) (*room.StatusChange, error) {
+	r, err := s.roomService.RetrieveRoomByHotelRoomID(ctx, hotelID, roomID, true)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != string(room.OCCUPIED) {
+		s.log.Infow("vacated room was not occupied", "roomID", roomID, "status", r.Status)
+		return nil, nil
+	}
+
+	return s.roomService.NewStatusChange(ctx, actor, hotelID, roomID, string(room.DIRTY), reason)
+}
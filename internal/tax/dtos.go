@@ -0,0 +1,61 @@
+package tax
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+)
+
+type CreateHotelTaxRequest struct {
+	Name     string          `json:"name" validate:"required,max=128"`
+	Kind     string          `json:"kind" validate:"required,oneof=percentage per_night"`
+	Amount   decimal.Decimal `json:"amount"`
+	PerGuest bool            `json:"per_guest"`
+}
+
+type CreateCountryTaxRequest struct {
+	Country  string          `json:"country" validate:"required,max=64"`
+	Name     string          `json:"name" validate:"required,max=128"`
+	Kind     string          `json:"kind" validate:"required,oneof=percentage per_night"`
+	Amount   decimal.Decimal `json:"amount"`
+	PerGuest bool            `json:"per_guest"`
+}
+
+type TaxResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	CreatedAt string          `json:"created_at"`
+	HotelID   *uuid.UUID      `json:"hotel_id,omitempty"`
+	Country   string          `json:"country,omitempty"`
+	Name      string          `json:"name"`
+	Kind      string          `json:"kind"`
+	Amount    decimal.Decimal `json:"amount"`
+	PerGuest  bool            `json:"per_guest"`
+}
+
+type ListTaxesResponse struct {
+	Results []TaxResponse `json:"results"`
+}
+
+func NewTaxResponse(t *Tax) TaxResponse {
+	return TaxResponse{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+		HotelID:   t.HotelID,
+		Country:   t.Country,
+		Name:      t.Name,
+		Kind:      t.Kind,
+		Amount:    t.Amount,
+		PerGuest:  t.PerGuest,
+	}
+}
+
+func NewListTaxesResponse(taxes Taxes) ListTaxesResponse {
+	responses := make([]TaxResponse, len(taxes))
+	for i, tax := range taxes {
+		responses[i] = NewTaxResponse(&tax)
+	}
+	return ListTaxesResponse{
+		Results: responses,
+	}
+}
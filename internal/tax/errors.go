@@ -0,0 +1,14 @@
+package tax
+
+import (
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrTaxNotFound   = terrors.NotFound("tax", "tax not found", nil)
+	ErrInvalidAmount = terrors.BadRequest(
+		"amount", "amount must be a non negative decimal number and percentages at most 100", nil,
+	)
+	ErrMissingCountry = terrors.BadRequest("country", "country query parameter is required", nil)
+)
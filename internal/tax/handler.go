@@ -0,0 +1,204 @@
+package tax
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/internal/membership"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"go.uber.org/zap"
+)
+
+type TaxController struct {
+	validator  *validator.Validate
+	taxService *TaxService
+	log        *zap.SugaredLogger
+}
+
+func NewController(
+	server *rest.HTTPServer,
+	validator *validator.Validate,
+	taxService *TaxService,
+	membershipChecker auth.HotelMembershipChecker,
+) *TaxController {
+	c := &TaxController{
+		validator:  validator,
+		taxService: taxService,
+		log:        logger.GetLogger(),
+	}
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateRead))
+		r.Use(middleware.RequireHotelMember(membershipChecker))
+		r.Get("/v1/hotels/{hotel_id}/taxes", c.handleListHotelTaxes)
+	})
+
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleRateWrite))
+		r.Use(middleware.RequireHotelMember(membershipChecker, string(membership.MANAGER)))
+		r.Post("/v1/hotels/{hotel_id}/taxes", c.handleCreateHotelTax)
+		r.Delete("/v1/hotels/{hotel_id}/taxes/{tax_id}", c.handleDeleteHotelTax)
+	})
+
+	// Country wide taxes apply to every hotel of the chain in the country
+	server.Router.Group(func(r chi.Router) {
+		r.Use(middleware.RequireRoles(auth.RoleAdmin))
+		r.Get("/v1/taxes", c.handleListCountryTaxes)
+		r.Post("/v1/taxes", c.handleCreateCountryTax)
+		r.Delete("/v1/taxes/{tax_id}", c.handleDeleteCountryTax)
+	})
+
+	return c
+}
+
+func (c *TaxController) handleListHotelTaxes(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	taxes, err := c.taxService.ListTaxesByHotelID(r.Context(), uuidHotelID)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListTaxesResponse(taxes)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *TaxController) handleCreateHotelTax(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	var payload CreateHotelTaxRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	tax, err := NewHotelTax(uuidHotelID, payload.Name, payload.Kind, payload.Amount, payload.PerGuest)
+	if err != nil {
+		c.log.Errorw("failure creating tax instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	tax, err = c.taxService.CreateTax(r.Context(), tax)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTaxResponse(tax)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *TaxController) handleDeleteHotelTax(w http.ResponseWriter, r *http.Request) {
+	hotelID := chi.URLParam(r, "hotel_id")
+	uuidHotelID, err := uuid.FromString(hotelID)
+	if err != nil {
+		c.log.Errorw("invalid hotel id", "hotelID", hotelID, "error", err)
+		rest.RenderError(r.Context(), w, ErrHotelNotFound)
+		return
+	}
+
+	taxID := chi.URLParam(r, "tax_id")
+	uuidTaxID, err := uuid.FromString(taxID)
+	if err != nil {
+		c.log.Errorw("invalid tax id", "taxID", taxID, "error", err)
+		rest.RenderError(r.Context(), w, ErrTaxNotFound)
+		return
+	}
+
+	if err := c.taxService.DeleteHotelTax(r.Context(), uuidHotelID, uuidTaxID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *TaxController) handleListCountryTaxes(w http.ResponseWriter, r *http.Request) {
+	taxes, err := c.taxService.ListTaxesByCountry(r.Context(), r.URL.Query().Get("country"))
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewListTaxesResponse(taxes)
+
+	rest.RenderJSON(r.Context(), w, http.StatusOK, resp)
+}
+
+func (c *TaxController) handleCreateCountryTax(w http.ResponseWriter, r *http.Request) {
+	var payload CreateCountryTaxRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		c.log.Errorw("failed to decode request body", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("body", "malformed request body", nil))
+		return
+	}
+	if err := c.validator.Struct(payload); err != nil {
+		c.log.Errorw("validation error", "error", err)
+		rest.RenderError(r.Context(), w, terrors.BadRequest("validation", err.Error(), nil))
+		return
+	}
+
+	tax, err := NewCountryTax(payload.Country, payload.Name, payload.Kind, payload.Amount, payload.PerGuest)
+	if err != nil {
+		c.log.Errorw("failure creating tax instance", "error", err)
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	tax, err = c.taxService.CreateTax(r.Context(), tax)
+	if err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	resp := NewTaxResponse(tax)
+
+	rest.RenderJSON(r.Context(), w, http.StatusCreated, resp)
+}
+
+func (c *TaxController) handleDeleteCountryTax(w http.ResponseWriter, r *http.Request) {
+	taxID := chi.URLParam(r, "tax_id")
+	uuidTaxID, err := uuid.FromString(taxID)
+	if err != nil {
+		c.log.Errorw("invalid tax id", "taxID", taxID, "error", err)
+		rest.RenderError(r.Context(), w, ErrTaxNotFound)
+		return
+	}
+
+	if err := c.taxService.DeleteCountryTax(r.Context(), uuidTaxID); err != nil {
+		rest.RenderError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package tax
+
+import (
+	"time"
+
+	"github.com/sebenitezg/hotel-service/internal/currency"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type Kind string
+
+const (
+	// PERCENTAGE taxes charge a percentage of the stay's room subtotal
+	PERCENTAGE Kind = "percentage"
+	// PER_NIGHT taxes charge a flat fee for every night of the stay
+	PER_NIGHT Kind = "per_night"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// --------------------
+// DB models
+// --------------------
+
+// Tax charged on the stays of a hotel, or on every hotel of a country when
+// HotelID is nil. Flat fees are denominated in the currency of the hotel.
+type Tax struct {
+	bun.BaseModel `bun:"table:taxes"`
+	ID            uuid.UUID       `bun:"id,pk"`
+	CreatedAt     time.Time       `bun:"created_at"`
+	HotelID       *uuid.UUID      `bun:"hotel_id"`
+	Country       string          `bun:"country,nullzero"`
+	Name          string          `bun:"name"`
+	Kind          string          `bun:"kind"`
+	Amount        decimal.Decimal `bun:"amount"`
+	PerGuest      bool            `bun:"per_guest"`
+}
+
+type Taxes []Tax
+
+// NewHotelTax Creates a tax charged on the stays of the hotel
+func NewHotelTax(
+	hotelID uuid.UUID,
+	name string,
+	kind string,
+	amount decimal.Decimal,
+	perGuest bool,
+) (*Tax, error) {
+	tax, err := newTax(name, kind, amount, perGuest)
+	if err != nil {
+		return nil, err
+	}
+	tax.HotelID = &hotelID
+	return tax, nil
+}
+
+// NewCountryTax Creates a tax charged on the stays of every hotel in the
+// country
+func NewCountryTax(
+	country string,
+	name string,
+	kind string,
+	amount decimal.Decimal,
+	perGuest bool,
+) (*Tax, error) {
+	tax, err := newTax(name, kind, amount, perGuest)
+	if err != nil {
+		return nil, err
+	}
+	tax.Country = country
+	return tax, nil
+}
+
+func newTax(name string, kind string, amount decimal.Decimal, perGuest bool) (*Tax, error) {
+	id, err := uuid.NewV6()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tax{
+		ID:        id,
+		CreatedAt: time.Now().UTC(),
+		Name:      name,
+		Kind:      kind,
+		Amount:    amount,
+		PerGuest:  perGuest,
+	}, nil
+}
+
+// Charge Amount the tax adds to a stay of nights for guests whose rooms cost
+// subtotal, rounded to the minor units of the currency. Percentages apply to
+// the room subtotal only, so taxes never compound. Flat fees are charged per
+// night, and per guest too when PerGuest is set.
+func (t *Tax) Charge(subtotal decimal.Decimal, nights int, guests int, currencyCode string) decimal.Decimal {
+	if Kind(t.Kind) == PERCENTAGE {
+		return currency.Round(subtotal.Mul(t.Amount).Div(hundred), currencyCode)
+	}
+
+	units := nights
+	if t.PerGuest {
+		units *= guests
+	}
+	return currency.Round(t.Amount.Mul(decimal.NewFromInt(int64(units))), currencyCode)
+}
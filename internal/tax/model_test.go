@@ -0,0 +1,52 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/sebenitezg/hotel-service/internal/tax"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTaxCharge(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     tax.Kind
+		amount   string
+		perGuest bool
+		subtotal string
+		nights   int
+		guests   int
+		currency string
+		want     string
+	}{
+		{name: "percentage", kind: tax.PERCENTAGE, amount: "10", subtotal: "333.33", nights: 3, guests: 2,
+			currency: "USD", want: "33.33"},
+		{name: "percentage half", kind: tax.PERCENTAGE, amount: "12.5", subtotal: "0.20", nights: 1, guests: 1,
+			currency: "USD", want: "0.03"},
+		{name: "percentage ignores guests", kind: tax.PERCENTAGE, amount: "10", perGuest: true, subtotal: "100.00",
+			nights: 2, guests: 3, currency: "USD", want: "10.00"},
+		{name: "percentage no minor units", kind: tax.PERCENTAGE, amount: "7.5", subtotal: "15002", nights: 2,
+			guests: 1, currency: "JPY", want: "1125"},
+		{name: "percentage three minor units", kind: tax.PERCENTAGE, amount: "5", subtotal: "24.69", nights: 2,
+			guests: 1, currency: "KWD", want: "1.235"},
+		{name: "per night", kind: tax.PER_NIGHT, amount: "2.50", subtotal: "300.00", nights: 3, guests: 2,
+			currency: "USD", want: "7.50"},
+		{name: "per night per guest", kind: tax.PER_NIGHT, amount: "2.50", perGuest: true, subtotal: "300.00",
+			nights: 3, guests: 2, currency: "USD", want: "15.00"},
+		{name: "per night per guest no minor units", kind: tax.PER_NIGHT, amount: "200", perGuest: true,
+			subtotal: "24690", nights: 2, guests: 3, currency: "JPY", want: "1200"},
+		{name: "per night three minor units half", kind: tax.PER_NIGHT, amount: "0.4445", subtotal: "30.000",
+			nights: 3, guests: 1, currency: "KWD", want: "1.334"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := tax.Tax{Kind: string(tt.kind), Amount: decimal.RequireFromString(tt.amount), PerGuest: tt.perGuest}
+			got := rule.Charge(decimal.RequireFromString(tt.subtotal), tt.nights, tt.guests, tt.currency)
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Fatalf("expected %s %s, got %s", tt.want, tt.currency, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/uptrace/bun"
+)
+
+type TaxRepository struct {
+	db *bun.DB
+}
+
+func NewRepository(db *bun.DB) *TaxRepository {
+	return &TaxRepository{
+		db: db,
+	}
+}
+
+func (r *TaxRepository) Save(ctx context.Context, tax *Tax) error {
+	_, err := r.db.NewInsert().Model(tax).Exec(ctx)
+	return err
+}
+
+func (r *TaxRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewDelete().Model((*Tax)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *TaxRepository) GetByID(ctx context.Context, id uuid.UUID) (*Tax, error) {
+	tax := new(Tax)
+	err := r.db.NewSelect().Model(tax).Where("id = ?", id).Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tax, nil
+}
+
+func (r *TaxRepository) GetByHotelID(ctx context.Context, hotelID uuid.UUID) (Taxes, error) {
+	var taxes Taxes
+	err := r.db.NewSelect().
+		Model(&taxes).
+		Where("hotel_id = ?", hotelID).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return taxes, nil
+}
+
+// GetByCountry Returns the country wide taxes, countries are compared
+// ignoring case
+func (r *TaxRepository) GetByCountry(ctx context.Context, country string) (Taxes, error) {
+	var taxes Taxes
+	err := r.db.NewSelect().
+		Model(&taxes).
+		Where("hotel_id IS NULL").
+		Where("lower(country) = lower(?)", country).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return taxes, nil
+}
+
+// GetApplicable Returns the taxes charged on the stays of the hotel, those of
+// its country first
+func (r *TaxRepository) GetApplicable(ctx context.Context, hotelID uuid.UUID, country string) (Taxes, error) {
+	var taxes Taxes
+	err := r.db.NewSelect().
+		Model(&taxes).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("hotel_id = ?", hotelID).
+				WhereOr("hotel_id IS NULL AND lower(country) = lower(?)", country)
+		}).
+		OrderExpr("hotel_id IS NOT NULL, created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return taxes, nil
+}
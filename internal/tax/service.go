@@ -0,0 +1,137 @@
+package tax
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/internal/core"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+type TaxService struct {
+	taxRepo        *TaxRepository
+	hotelValidator core.HotelValidator
+	log            *zap.SugaredLogger
+}
+
+func NewService(
+	taxRepo *TaxRepository,
+	hotelValidator core.HotelValidator,
+) *TaxService {
+	return &TaxService{
+		taxRepo:        taxRepo,
+		hotelValidator: hotelValidator,
+		log:            logger.GetLogger(),
+	}
+}
+
+func (s *TaxService) ListTaxesByHotelID(ctx context.Context, hotelID uuid.UUID) (Taxes, error) {
+	taxes, err := s.taxRepo.GetByHotelID(ctx, hotelID)
+	if err != nil {
+		s.log.Errorw("error retrieving hotel's taxes", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return taxes, nil
+}
+
+func (s *TaxService) ListTaxesByCountry(ctx context.Context, country string) (Taxes, error) {
+	if country == "" {
+		return nil, ErrMissingCountry
+	}
+
+	taxes, err := s.taxRepo.GetByCountry(ctx, country)
+	if err != nil {
+		s.log.Errorw("error retrieving country's taxes", "country", country, "error", err)
+		return nil, err
+	}
+	return taxes, nil
+}
+
+// ApplicableTaxes Returns the taxes charged on the stays of the hotel, which
+// are those of the hotel plus the ones of its country
+func (s *TaxService) ApplicableTaxes(ctx context.Context, hotelID uuid.UUID, country string) (Taxes, error) {
+	taxes, err := s.taxRepo.GetApplicable(ctx, hotelID, country)
+	if err != nil {
+		s.log.Errorw("error retrieving applicable taxes", "hotelID", hotelID, "error", err)
+		return nil, err
+	}
+	return taxes, nil
+}
+
+func (s *TaxService) CreateTax(ctx context.Context, tax *Tax) (*Tax, error) {
+	if err := validateAmount(tax); err != nil {
+		return nil, err
+	}
+
+	if tax.HotelID != nil {
+		hotelExist, err := s.hotelValidator.ValidateHotelExists(ctx, *tax.HotelID)
+		if err != nil {
+			s.log.Errorw("error validating hotel existence", "hotelID", *tax.HotelID, "error", err)
+			return nil, err
+		}
+		if !hotelExist {
+			return nil, ErrHotelNotFound
+		}
+	}
+
+	if err := s.taxRepo.Save(ctx, tax); err != nil {
+		s.log.Errorw("error creating tax", "error", err)
+		return nil, err
+	}
+
+	s.log.Infow("tax created successfully", "taxID", tax.ID)
+
+	return tax, nil
+}
+
+// DeleteHotelTax Removes a tax of the hotel, country wide taxes can not be
+// removed through a hotel
+func (s *TaxService) DeleteHotelTax(ctx context.Context, hotelID uuid.UUID, taxID uuid.UUID) error {
+	tax, err := s.taxRepo.GetByID(ctx, taxID)
+	if err != nil {
+		s.log.Errorw("error retrieving tax", "taxID", taxID, "error", err)
+		return err
+	}
+	if tax == nil || tax.HotelID == nil || *tax.HotelID != hotelID {
+		return ErrTaxNotFound
+	}
+
+	return s.deleteTax(ctx, tax)
+}
+
+// DeleteCountryTax Removes a country wide tax
+func (s *TaxService) DeleteCountryTax(ctx context.Context, taxID uuid.UUID) error {
+	tax, err := s.taxRepo.GetByID(ctx, taxID)
+	if err != nil {
+		s.log.Errorw("error retrieving tax", "taxID", taxID, "error", err)
+		return err
+	}
+	if tax == nil || tax.HotelID != nil {
+		return ErrTaxNotFound
+	}
+
+	return s.deleteTax(ctx, tax)
+}
+
+func (s *TaxService) deleteTax(ctx context.Context, tax *Tax) error {
+	if err := s.taxRepo.Delete(ctx, tax.ID); err != nil {
+		s.log.Errorw("error deleting tax", "taxID", tax.ID, "error", err)
+		return err
+	}
+
+	s.log.Infow("tax deleted successfully", "taxID", tax.ID)
+
+	return nil
+}
+
+func validateAmount(tax *Tax) error {
+	if tax.Amount.IsNegative() {
+		return ErrInvalidAmount
+	}
+	if Kind(tax.Kind) == PERCENTAGE && tax.Amount.GreaterThan(hundred) {
+		return ErrInvalidAmount
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrHotelNotFound   = terrors.NotFound("hotel", "hotel does not exist", nil)
+	ErrNotHotelMember  = terrors.Forbidden("hotel_membership", "principal does not operate this hotel", nil)
+	ErrHotelRoleDenied = terrors.Forbidden("hotel_membership", "principal's hotel role does not allow this operation", nil)
+)
+
+// HotelMembershipChecker resolves the role a principal holds in a hotel,
+// returning an empty string when it is not a member.
+type HotelMembershipChecker interface {
+	GetHotelRole(ctx context.Context, principal string, hotelID uuid.UUID) (string, error)
+}
+
+// AuthorizeHotel Rejects principals that are not members of the hotel or,
+// when hotelRoles are given, whose membership holds none of them.
+// Administrators are always allowed.
+func AuthorizeHotel(
+	ctx context.Context, checker HotelMembershipChecker, principal *Principal, hotelID uuid.UUID, hotelRoles ...string,
+) error {
+	if principal.IsAdmin() {
+		return nil
+	}
+
+	role, err := checker.GetHotelRole(ctx, principal.Subject, hotelID)
+	if err != nil {
+		logger.WithContext(ctx).Errorw(
+			"error resolving hotel membership",
+			"principal", principal.Subject, "hotelID", hotelID, "error", err,
+		)
+		return err
+	}
+	if role == "" {
+		return ErrNotHotelMember
+	}
+	if len(hotelRoles) > 0 && !slices.Contains(hotelRoles, role) {
+		return ErrHotelRoleDenied
+	}
+	return nil
+}
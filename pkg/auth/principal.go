@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// Roles granted to API principals through the `roles` token claim.
+const (
+	// RoleAdmin operates every hotel regardless of its memberships
+	RoleAdmin             = "admin"
+	RoleHotelRead         = "hotel:read"
+	RoleHotelWrite        = "hotel:write"
+	RoleRoomRead          = "room:read"
+	RoleRoomWrite         = "room:write"
+	RoleReservationRead   = "reservation:read"
+	RoleReservationWrite  = "reservation:write"
+	RoleRateRead          = "rate:read"
+	RoleRateWrite         = "rate:write"
+	RoleHousekeepingRead  = "housekeeping:read"
+	RoleHousekeepingWrite = "housekeeping:write"
+	RoleGuestRead         = "guest:read"
+	RoleGuestWrite        = "guest:write"
+	RoleFolioRead         = "folio:read"
+	RoleFolioWrite        = "folio:write"
+)
+
+type principalKey struct{}
+
+// Principal authenticated caller of a request
+type Principal struct {
+	Subject string
+	Roles   []string
+}
+
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// WithPrincipal Returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext Returns the principal stored by the authentication
+// of the request
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// SystemPrincipal acts on behalf of the service itself, such as background
+// jobs, and is not bound to any hotel membership.
+var SystemPrincipal = &Principal{
+	Subject: "system",
+	Roles:   []string{RoleAdmin},
+}
+
+// IsAdmin Reports whether the principal operates every hotel
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin)
+}
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/extra/bundebug"
@@ -44,8 +47,14 @@ func NewConnection(dbConfig config.DatabaseConfigurations) *bun.DB {
 
 	log.Printf("Successfully connected to database")
 
+	// Pool stats and query durations are exposed on /metrics, queries are
+	// traced as children of the span of their context
+	metrics.Register(collectors.NewDBStatsCollector(conn, parsedCfg.Database))
+
 	db := bun.NewDB(conn, pgdialect.New(), bun.WithDiscardUnknownColumns())
 	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(dbConfig.LogQueries)))
+	db.AddQueryHook(metrics.NewQueryHook())
+	db.AddQueryHook(tracing.NewQueryHook(parsedCfg.Database))
 
 	return db
 }
@@ -0,0 +1,97 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// DatabasePing Checks the database answers a ping
+func DatabasePing(db *bun.DB) Check {
+	return func(ctx context.Context) Result {
+		if err := db.PingContext(ctx); err != nil {
+			return Fail(err, nil)
+		}
+		return Pass(nil)
+	}
+}
+
+// MigrationVersion Checks the database schema is at least at the version of
+// the latest dbmate migration found in migrationsDir, so the service does
+// not take traffic before its migrations ran
+func MigrationVersion(db *bun.DB, migrationsDir string) (Check, error) {
+	expected, err := latestMigration(migrationsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) Result {
+		var current string
+		err := db.NewSelect().
+			TableExpr("schema_migrations").
+			ColumnExpr("COALESCE(MAX(version), '')").
+			Scan(ctx, &current)
+
+		details := map[string]any{"expected": expected, "current": current}
+		if err != nil {
+			return Fail(err, details)
+		}
+		// Versions are timestamps of the same length, newer schemas of a
+		// rollout in progress are fine
+		if current < expected {
+			return Fail(fmt.Errorf("database schema is behind, expected version %s", expected), details)
+		}
+		return Pass(details)
+	}, nil
+}
+
+// PoolSaturation Reports the usage of the connection pool, warning when
+// every connection is in use. A saturated pool slows requests down but does
+// not fail readiness, otherwise every replica would be taken out at once
+// under load.
+func PoolSaturation(db *bun.DB) Check {
+	return func(ctx context.Context) Result {
+		stats := db.Stats()
+
+		details := map[string]any{
+			"max_open":      stats.MaxOpenConnections,
+			"open":          stats.OpenConnections,
+			"in_use":        stats.InUse,
+			"idle":          stats.Idle,
+			"wait_count":    stats.WaitCount,
+			"wait_duration": stats.WaitDuration.String(),
+		}
+		if stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections {
+			return Result{Status: WARN, Error: "every connection of the pool is in use", Details: details}
+		}
+		return Pass(details)
+	}
+}
+
+// latestMigration Returns the version of the latest migration of the
+// directory, dbmate names migrations <version>_<name>.sql
+func latestMigration(migrationsDir string) (string, error) {
+	entries, err := os.ReadDir(migrationsDir)
+	if err != nil {
+		return "", err
+	}
+
+	var latest string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		version, _, _ := strings.Cut(name, "_")
+		if version > latest {
+			latest = version
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("no migrations found in %s", migrationsDir)
+	}
+	return latest, nil
+}
@@ -0,0 +1,161 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"github.com/goccy/go-json"
+	"go.uber.org/zap"
+)
+
+type Status string
+
+const (
+	PASS Status = "pass"
+	// WARN checks report a degraded dependency without failing readiness
+	WARN Status = "warn"
+	FAIL Status = "fail"
+)
+
+// checkTimeout longest a readiness check may take before it fails
+const checkTimeout = 2 * time.Second
+
+// Result outcome of a check, Details are dependency specific
+type Result struct {
+	Status    Status         `json:"status"`
+	LatencyMS float64        `json:"latency_ms"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// Check probes a dependency of the service. Latency is measured by the
+// Checker and does not need to be set.
+type Check func(ctx context.Context) Result
+
+type Report struct {
+	Status Status            `json:"status"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+// Checker answers the liveness and readiness probes of the service. The
+// service is ready while every check passes or warns and it is not
+// shutting down.
+type Checker struct {
+	checks   map[string]Check
+	draining atomic.Bool
+	log      *zap.SugaredLogger
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		checks: make(map[string]Check),
+		log:    logger.GetLogger(),
+	}
+}
+
+// Add Registers a readiness check
+func (c *Checker) Add(name string, check Check) {
+	c.checks[name] = check
+}
+
+// Drain Fails readiness from now on, so no new traffic is routed to the
+// service while it shuts down
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Pass Returns a passing result
+func Pass(details map[string]any) Result {
+	return Result{Status: PASS, Details: details}
+}
+
+// Fail Returns a failing result caused by err
+func Fail(err error, details map[string]any) Result {
+	return Result{Status: FAIL, Error: err.Error(), Details: details}
+}
+
+// Ready Runs the checks concurrently and reports their results. Checks are
+// skipped once the service is shutting down.
+func (c *Checker) Ready(ctx context.Context) Report {
+	if c.draining.Load() {
+		return Report{
+			Status: FAIL,
+			Checks: map[string]Result{"shutdown": {Status: FAIL, Error: "service is shutting down"}},
+		}
+	}
+
+	report := Report{Status: PASS, Checks: make(map[string]Result, len(c.checks))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			started := time.Now()
+			result := check(checkCtx)
+			result.LatencyMS = float64(time.Since(started).Microseconds()) / 1000
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+		}()
+	}
+	wg.Wait()
+
+	for name, result := range report.Checks {
+		switch result.Status {
+		case FAIL:
+			c.log.Warnw("readiness check failed", "check", name, "error", result.Error)
+			report.Status = FAIL
+		case WARN:
+			if report.Status == PASS {
+				report.Status = WARN
+			}
+		}
+	}
+
+	return report
+}
+
+// HandleLiveness Reports the process is alive, regardless of its
+// dependencies
+func (c *Checker) HandleLiveness(w http.ResponseWriter, r *http.Request) {
+	render(w, http.StatusOK, Report{Status: PASS})
+}
+
+// HandleReadiness Reports whether the service can take traffic, with a 503
+// status when it can not
+func (c *Checker) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	report := c.Ready(r.Context())
+
+	status := http.StatusOK
+	if report.Status == FAIL {
+		status = http.StatusServiceUnavailable
+	}
+	render(w, status, report)
+}
+
+func render(w http.ResponseWriter, status int, report Report) {
+	js, err := json.Marshal(report)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_, _ = w.Write(js)
+}
@@ -0,0 +1,169 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// DefaultShutdownTimeout time given to servers and workers to drain when no
+// deadline is configured
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ErrShutdownTimeout is returned by Run when the servers or workers did not
+// drain before the deadline
+var ErrShutdownTimeout = errors.New("shutdown deadline exceeded")
+
+type server struct {
+	name     string
+	serve    func() error
+	shutdown func(ctx context.Context) error
+}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Manager runs the servers and background workers of the process until
+// SIGINT or SIGTERM is received or one of the servers fails. On shutdown
+// servers and workers are drained together within the deadline, then the
+// closers are run in the order they were registered.
+type Manager struct {
+	timeout time.Duration
+	servers []server
+	workers []worker
+	closers []closer
+	log     *zap.SugaredLogger
+}
+
+func NewManager(shutdownTimeout time.Duration) *Manager {
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = DefaultShutdownTimeout
+	}
+	return &Manager{
+		timeout: shutdownTimeout,
+		log:     logger.GetLogger(),
+	}
+}
+
+// Serve Registers a server. serve blocks until the server stops and returns
+// nil once it was shut down, shutdown stops accepting work and waits for
+// the in-flight one until ctx is done.
+func (m *Manager) Serve(name string, serve func() error, shutdown func(ctx context.Context) error) {
+	m.servers = append(m.servers, server{name: name, serve: serve, shutdown: shutdown})
+}
+
+// Go Registers a background worker running until its context is cancelled
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	m.workers = append(m.workers, worker{name: name, run: run})
+}
+
+// OnClose Registers a resource released once servers and workers stopped
+func (m *Manager) OnClose(name string, close func() error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run Starts the servers and workers and blocks until the process is
+// shut down. It returns the error of the server that failed, if any, or
+// ErrShutdownTimeout when draining took longer than the deadline.
+func (m *Manager) Run() error {
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+	for _, w := range m.workers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			w.run(workersCtx)
+			m.log.Infow("worker stopped", "worker", w.name)
+		}()
+	}
+
+	failures := make(chan error, len(m.servers))
+	for _, s := range m.servers {
+		go func() {
+			if err := s.serve(); err != nil {
+				m.log.Errorw("server failed", "server", s.name, "error", err)
+				failures <- err
+			}
+		}()
+	}
+
+	var runErr error
+	select {
+	case <-signalCtx.Done():
+		m.log.Infow("shutdown signal received, draining", "timeout", m.timeout)
+	case runErr = <-failures:
+		m.log.Infow("shutting down after a server failure", "timeout", m.timeout)
+	}
+	// A second signal kills the process right away
+	stopSignals()
+
+	if err := m.drain(stopWorkers, &workers); err != nil {
+		runErr = errors.Join(runErr, err)
+	}
+
+	for _, c := range m.closers {
+		if err := c.close(); err != nil {
+			m.log.Errorw("failure closing resource", "resource", c.name, "error", err)
+			runErr = errors.Join(runErr, err)
+		}
+	}
+
+	return runErr
+}
+
+// drain Shuts the servers down and stops the workers, waiting for all of
+// them until the deadline
+func (m *Manager) drain(stopWorkers context.CancelFunc, workers *sync.WaitGroup) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	stopWorkers()
+
+	var servers sync.WaitGroup
+	for _, s := range m.servers {
+		servers.Add(1)
+		go func() {
+			defer servers.Done()
+			if err := s.shutdown(ctx); err != nil {
+				m.log.Errorw("failure shutting server down", "server", s.name, "error", err)
+				return
+			}
+			m.log.Infow("server stopped", "server", s.name)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		servers.Wait()
+		workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		m.log.Errorw("servers and workers did not drain before the deadline", "timeout", m.timeout)
+		return ErrShutdownTimeout
+	}
+}
@@ -1,10 +1,12 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"sync"
 
 	"go.elastic.co/ecszap"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
 )
@@ -46,3 +48,16 @@ func GetLogger(debugMode ...bool) *zap.SugaredLogger {
 func CloseLogger() {
 	_ = logger.Sync()
 }
+
+// WithContext Returns the logger with the trace.id and span.id ECS fields of
+// the span of ctx, so log lines can be correlated with their trace. The
+// logger is returned as is when ctx carries no span.
+func WithContext(ctx context.Context) *zap.SugaredLogger {
+	log := GetLogger()
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return log
+	}
+	return log.With("trace.id", spanContext.TraceID().String(), "span.id", spanContext.SpanID().String())
+}
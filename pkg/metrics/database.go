@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+)
+
+var dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "db_query_duration_seconds",
+	Help:      "Duration of the database queries by operation and outcome.",
+	Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+}, []string{"operation", "status"})
+
+func init() {
+	registry.MustRegister(dbQueryDuration)
+}
+
+// QueryHook bun query hook measuring the duration of the queries
+type QueryHook struct{}
+
+var _ bun.QueryHook = (*QueryHook)(nil)
+
+func NewQueryHook() *QueryHook {
+	return &QueryHook{}
+}
+
+func (h *QueryHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (h *QueryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	status := "ok"
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		status = "error"
+	}
+	dbQueryDuration.WithLabelValues(event.Operation(), status).Observe(time.Since(event.StartTime).Seconds())
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute route label of the requests no route matched, so unknown
+// paths do not create new series. They only match the catch-all pattern the
+// router is mounted on.
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests served by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the HTTP requests by method, route pattern and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+)
+
+func init() {
+	registry.MustRegister(httpRequests, httpDuration)
+}
+
+// Middleware Measures the requests served by the router. Requests are
+// labelled with the chi route pattern they matched rather than their path.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := unmatchedRoute
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			if pattern := rctx.RoutePattern(); pattern != "" && pattern != "/*" {
+				route = pattern
+			}
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		labels := prometheus.Labels{"method": r.Method, "route": route, "status": strconv.Itoa(status)}
+		httpRequests.With(labels).Inc()
+		httpDuration.With(labels).Observe(time.Since(started).Seconds())
+	})
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefix of the metrics of the service
+const namespace = "hotel_service"
+
+// registry holds the metrics of the service along with the Go runtime and
+// process ones
+var registry = prometheus.NewRegistry()
+
+var (
+	entityChanges = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "entity_changes_total",
+		Help:      "Changes made to hotels, room types and rooms by action.",
+	}, []string{"entity", "action"})
+
+	validationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "validation_failures_total",
+		Help:      "Request bodies of hotels, room types and rooms rejected by validation.",
+	}, []string{"entity"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		entityChanges,
+		validationFailures,
+	)
+}
+
+// Handler Serves the registered metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Register Registers collectors along with the metrics of the service
+func Register(cs ...prometheus.Collector) {
+	registry.MustRegister(cs...)
+}
+
+// RecordChange Counts a change made to an entity, action is one of the
+// audit log actions such as core.ActionCreate
+func RecordChange(entity string, action string) {
+	entityChanges.WithLabelValues(entity, action).Inc()
+}
+
+// RecordValidationFailure Counts a request body of the entity rejected by
+// validation
+func RecordValidationFailure(entity string) {
+	validationFailures.WithLabelValues(entity).Inc()
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var outboxDeadMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "outbox_dead_messages_total",
+	Help:      "Outbox messages given up after exhausting their delivery attempts by event type.",
+}, []string{"event_type"})
+
+func init() {
+	registry.MustRegister(outboxDeadMessages)
+}
+
+// RecordDeadOutboxMessage Counts an outbox message of the event type that
+// exhausted its delivery attempts and will not be published
+func RecordDeadOutboxMessage(eventType string) {
+	outboxDeadMessages.WithLabelValues(eventType).Inc()
+}
@@ -0,0 +1,139 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/monzo/terrors"
+	"github.com/uptrace/bun"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+var (
+	ErrInvalidLimit  = terrors.BadRequest("limit", fmt.Sprintf("limit must be between 1 and %d", MaxLimit), nil)
+	ErrInvalidCursor = terrors.BadRequest("cursor", "invalid pagination cursor", nil)
+	ErrInvalidSort   = terrors.BadRequest("sort", "unsupported sort field", nil)
+)
+
+// Params keyset pagination parameters of a list request. Rows are ordered
+// by the sort column and then by id, so the cursor is stable even when
+// several rows share the same sort value.
+type Params struct {
+	Limit  int
+	Sort   string
+	column string
+	desc   bool
+	cursor *cursor
+}
+
+// cursor position of the last row returned, opaque to the clients
+type cursor struct {
+	Sort  string    `json:"s"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// NewParams Validates the pagination parameters. sortable maps the public
+// sort keys to their column, a leading "-" in sort requests descending
+// order.
+func NewParams(
+	limit int,
+	rawCursor string,
+	sort string,
+	sortable map[string]string,
+	defaultSort string,
+) (Params, error) {
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit < 1 || limit > MaxLimit {
+		return Params{}, ErrInvalidLimit
+	}
+
+	if sort == "" {
+		sort = defaultSort
+	}
+	key, desc := strings.CutPrefix(sort, "-")
+	column, ok := sortable[key]
+	if !ok {
+		return Params{}, ErrInvalidSort
+	}
+
+	p := Params{
+		Limit:  limit,
+		Sort:   sort,
+		column: column,
+		desc:   desc,
+	}
+
+	if rawCursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(rawCursor)
+		if err != nil {
+			return Params{}, ErrInvalidCursor
+		}
+		var c cursor
+		if err := json.Unmarshal(decoded, &c); err != nil || c.Sort != sort {
+			return Params{}, ErrInvalidCursor
+		}
+		p.cursor = &c
+	}
+
+	return p, nil
+}
+
+// ParseQuery Reads the limit, cursor and sort query parameters
+func ParseQuery(query url.Values, sortable map[string]string, defaultSort string) (Params, error) {
+	var limit int
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		var err error
+		limit, err = strconv.Atoi(rawLimit)
+		if err != nil {
+			return Params{}, ErrInvalidLimit
+		}
+	}
+
+	return NewParams(limit, query.Get("cursor"), query.Get("sort"), sortable, defaultSort)
+}
+
+// Apply Adds the keyset condition, ordering and limit to the query. One
+// extra row is fetched to know whether there is a next page.
+func (p Params) Apply(q *bun.SelectQuery) *bun.SelectQuery {
+	direction, operator := "ASC", ">"
+	if p.desc {
+		direction, operator = "DESC", "<"
+	}
+
+	if p.cursor != nil {
+		q = q.Where("(?, id) "+operator+" (?, ?)", bun.Ident(p.column), p.cursor.Value, p.cursor.ID)
+	}
+
+	return q.
+		OrderExpr("? "+direction, bun.Ident(p.column)).
+		OrderExpr("id " + direction).
+		Limit(p.Limit + 1)
+}
+
+// Paginate Trims the extra row fetched by Apply and returns the cursor of
+// the next page, empty on the last page. key returns the value of the sort
+// column and the id of an item.
+func Paginate[T any](items []T, p Params, key func(item T, column string) (string, uuid.UUID)) ([]T, string) {
+	if len(items) <= p.Limit {
+		return items, ""
+	}
+
+	items = items[:p.Limit]
+	value, id := key(items[len(items)-1], p.column)
+
+	raw, _ := json.Marshal(cursor{Sort: p.Sort, Value: value, ID: id})
+
+	return items, base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: hotel/v1/hotel.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Hotel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hotel) Reset() {
+	*x = Hotel{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hotel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hotel) ProtoMessage() {}
+
+func (x *Hotel) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
+func (*Hotel) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Hotel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hotel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Hotel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Hotel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hotel) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Hotel) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Hotel) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Hotel) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Hotel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelRequest) Reset() {
+	*x = GetHotelRequest{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelRequest) ProtoMessage() {}
+
+func (x *GetHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHotelRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type ListHotelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page size, defaults to 50 and cannot exceed 200
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque next_cursor of the previous page
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// One of name, country, created_at, prefixed with "-" for descending order
+	Sort          string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Country       string `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	State         string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHotelsRequest) Reset() {
+	*x = ListHotelsRequest{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHotelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHotelsRequest) ProtoMessage() {}
+
+func (x *ListHotelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHotelsRequest.ProtoReflect.Descriptor instead.
+func (*ListHotelsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListHotelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHotelsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListHotelsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListHotelsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListHotelsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListHotelsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ListHotelsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*Hotel               `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Empty on the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHotelsResponse) Reset() {
+	*x = ListHotelsResponse{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHotelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHotelsResponse) ProtoMessage() {}
+
+func (x *ListHotelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHotelsResponse.ProtoReflect.Descriptor instead.
+func (*ListHotelsResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListHotelsResponse) GetResults() []*Hotel {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListHotelsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHotelRequest) Reset() {
+	*x = CreateHotelRequest{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHotelRequest) ProtoMessage() {}
+
+func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHotelRequest.ProtoReflect.Descriptor instead.
+func (*CreateHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateHotelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Address       *string                `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHotelRequest) Reset() {
+	*x = UpdateHotelRequest{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHotelRequest) ProtoMessage() {}
+
+func (x *UpdateHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHotelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateHotelRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type DeleteHotelRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// Also removes the hotel's room types and rooms
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHotelRequest) Reset() {
+	*x = DeleteHotelRequest{}
+	mi := &file_hotel_v1_hotel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHotelRequest) ProtoMessage() {}
+
+func (x *DeleteHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_hotel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHotelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_hotel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteHotelRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteHotelRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+var File_hotel_v1_hotel_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_hotel_proto_rawDesc = "" +
+	"\n" +
+	"\x14hotel/v1/hotel.proto\x12\bhotel.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x02\n" +
+	"\x05Hotel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\",\n" +
+	"\x0fGetHotelRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\"\x9d\x01\n" +
+	"\x11ListHotelsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\"`\n" +
+	"\x12ListHotelsResponse\x12)\n" +
+	"\aresults\x18\x01 \x03(\v2\x0f.hotel.v1.HotelR\aresults\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xac\x01\n" +
+	"\x12CreateHotelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xdb\x01\n" +
+	"\x12UpdateHotelRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x03 \x01(\tH\x01R\aaddress\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_addressB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_description\"I\n" +
+	"\x12DeleteHotelRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade2\xd0\x02\n" +
+	"\fHotelService\x126\n" +
+	"\bGetHotel\x12\x19.hotel.v1.GetHotelRequest\x1a\x0f.hotel.v1.Hotel\x12G\n" +
+	"\n" +
+	"ListHotels\x12\x1b.hotel.v1.ListHotelsRequest\x1a\x1c.hotel.v1.ListHotelsResponse\x12<\n" +
+	"\vCreateHotel\x12\x1c.hotel.v1.CreateHotelRequest\x1a\x0f.hotel.v1.Hotel\x12<\n" +
+	"\vUpdateHotel\x12\x1c.hotel.v1.UpdateHotelRequest\x1a\x0f.hotel.v1.Hotel\x12C\n" +
+	"\vDeleteHotel\x12\x1c.hotel.v1.DeleteHotelRequest\x1a\x16.google.protobuf.EmptyB=Z;github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_hotel_proto_rawDescOnce sync.Once
+	file_hotel_v1_hotel_proto_rawDescData []byte
+)
+
+func file_hotel_v1_hotel_proto_rawDescGZIP() []byte {
+	file_hotel_v1_hotel_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_hotel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_hotel_proto_rawDesc), len(file_hotel_v1_hotel_proto_rawDesc)))
+	})
+	return file_hotel_v1_hotel_proto_rawDescData
+}
+
+var file_hotel_v1_hotel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hotel_v1_hotel_proto_goTypes = []any{
+	(*Hotel)(nil),                 // 0: hotel.v1.Hotel
+	(*GetHotelRequest)(nil),       // 1: hotel.v1.GetHotelRequest
+	(*ListHotelsRequest)(nil),     // 2: hotel.v1.ListHotelsRequest
+	(*ListHotelsResponse)(nil),    // 3: hotel.v1.ListHotelsResponse
+	(*CreateHotelRequest)(nil),    // 4: hotel.v1.CreateHotelRequest
+	(*UpdateHotelRequest)(nil),    // 5: hotel.v1.UpdateHotelRequest
+	(*DeleteHotelRequest)(nil),    // 6: hotel.v1.DeleteHotelRequest
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_hotel_v1_hotel_proto_depIdxs = []int32{
+	7, // 0: hotel.v1.Hotel.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: hotel.v1.Hotel.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: hotel.v1.ListHotelsResponse.results:type_name -> hotel.v1.Hotel
+	1, // 3: hotel.v1.HotelService.GetHotel:input_type -> hotel.v1.GetHotelRequest
+	2, // 4: hotel.v1.HotelService.ListHotels:input_type -> hotel.v1.ListHotelsRequest
+	4, // 5: hotel.v1.HotelService.CreateHotel:input_type -> hotel.v1.CreateHotelRequest
+	5, // 6: hotel.v1.HotelService.UpdateHotel:input_type -> hotel.v1.UpdateHotelRequest
+	6, // 7: hotel.v1.HotelService.DeleteHotel:input_type -> hotel.v1.DeleteHotelRequest
+	0, // 8: hotel.v1.HotelService.GetHotel:output_type -> hotel.v1.Hotel
+	3, // 9: hotel.v1.HotelService.ListHotels:output_type -> hotel.v1.ListHotelsResponse
+	0, // 10: hotel.v1.HotelService.CreateHotel:output_type -> hotel.v1.Hotel
+	0, // 11: hotel.v1.HotelService.UpdateHotel:output_type -> hotel.v1.Hotel
+	8, // 12: hotel.v1.HotelService.DeleteHotel:output_type -> google.protobuf.Empty
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_hotel_proto_init() }
+func file_hotel_v1_hotel_proto_init() {
+	if File_hotel_v1_hotel_proto != nil {
+		return
+	}
+	file_hotel_v1_hotel_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_hotel_proto_rawDesc), len(file_hotel_v1_hotel_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hotel_v1_hotel_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_hotel_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_hotel_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_hotel_proto = out.File
+	file_hotel_v1_hotel_proto_goTypes = nil
+	file_hotel_v1_hotel_proto_depIdxs = nil
+}
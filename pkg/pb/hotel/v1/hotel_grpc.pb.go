@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: hotel/v1/hotel.proto
+
+package hotelv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HotelService_GetHotel_FullMethodName    = "/hotel.v1.HotelService/GetHotel"
+	HotelService_ListHotels_FullMethodName  = "/hotel.v1.HotelService/ListHotels"
+	HotelService_CreateHotel_FullMethodName = "/hotel.v1.HotelService/CreateHotel"
+	HotelService_UpdateHotel_FullMethodName = "/hotel.v1.HotelService/UpdateHotel"
+	HotelService_DeleteHotel_FullMethodName = "/hotel.v1.HotelService/DeleteHotel"
+)
+
+// HotelServiceClient is the client API for HotelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HotelService mirrors the /v1/hotels REST endpoints.
+type HotelServiceClient interface {
+	GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*Hotel, error)
+	ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error)
+	CreateHotel(ctx context.Context, in *CreateHotelRequest, opts ...grpc.CallOption) (*Hotel, error)
+	UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*Hotel, error)
+	DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type hotelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHotelServiceClient(cc grpc.ClientConnInterface) HotelServiceClient {
+	return &hotelServiceClient{cc}
+}
+
+func (c *hotelServiceClient) GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*Hotel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hotel)
+	err := c.cc.Invoke(ctx, HotelService_GetHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHotelsResponse)
+	err := c.cc.Invoke(ctx, HotelService_ListHotels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) CreateHotel(ctx context.Context, in *CreateHotelRequest, opts ...grpc.CallOption) (*Hotel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hotel)
+	err := c.cc.Invoke(ctx, HotelService_CreateHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*Hotel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hotel)
+	err := c.cc.Invoke(ctx, HotelService_UpdateHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, HotelService_DeleteHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HotelServiceServer is the server API for HotelService service.
+// All implementations must embed UnimplementedHotelServiceServer
+// for forward compatibility.
+//
+// HotelService mirrors the /v1/hotels REST endpoints.
+type HotelServiceServer interface {
+	GetHotel(context.Context, *GetHotelRequest) (*Hotel, error)
+	ListHotels(context.Context, *ListHotelsRequest) (*ListHotelsResponse, error)
+	CreateHotel(context.Context, *CreateHotelRequest) (*Hotel, error)
+	UpdateHotel(context.Context, *UpdateHotelRequest) (*Hotel, error)
+	DeleteHotel(context.Context, *DeleteHotelRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedHotelServiceServer()
+}
+
+// UnimplementedHotelServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHotelServiceServer struct{}
+
+func (UnimplementedHotelServiceServer) GetHotel(context.Context, *GetHotelRequest) (*Hotel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotel not implemented")
+}
+func (UnimplementedHotelServiceServer) ListHotels(context.Context, *ListHotelsRequest) (*ListHotelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHotels not implemented")
+}
+func (UnimplementedHotelServiceServer) CreateHotel(context.Context, *CreateHotelRequest) (*Hotel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
+}
+func (UnimplementedHotelServiceServer) UpdateHotel(context.Context, *UpdateHotelRequest) (*Hotel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHotel not implemented")
+}
+func (UnimplementedHotelServiceServer) DeleteHotel(context.Context, *DeleteHotelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHotel not implemented")
+}
+func (UnimplementedHotelServiceServer) mustEmbedUnimplementedHotelServiceServer() {}
+func (UnimplementedHotelServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeHotelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HotelServiceServer will
+// result in compilation errors.
+type UnsafeHotelServiceServer interface {
+	mustEmbedUnimplementedHotelServiceServer()
+}
+
+func RegisterHotelServiceServer(s grpc.ServiceRegistrar, srv HotelServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHotelServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HotelService_ServiceDesc, srv)
+}
+
+func _HotelService_GetHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetHotel(ctx, req.(*GetHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_ListHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHotelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).ListHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_ListHotels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).ListHotels(ctx, req.(*ListHotelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreateHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreateHotel(ctx, req.(*CreateHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).UpdateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_UpdateHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).UpdateHotel(ctx, req.(*UpdateHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_DeleteHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).DeleteHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_DeleteHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).DeleteHotel(ctx, req.(*DeleteHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HotelService_ServiceDesc is the grpc.ServiceDesc for HotelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HotelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.HotelService",
+	HandlerType: (*HotelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHotel",
+			Handler:    _HotelService_GetHotel_Handler,
+		},
+		{
+			MethodName: "ListHotels",
+			Handler:    _HotelService_ListHotels_Handler,
+		},
+		{
+			MethodName: "CreateHotel",
+			Handler:    _HotelService_CreateHotel_Handler,
+		},
+		{
+			MethodName: "UpdateHotel",
+			Handler:    _HotelService_UpdateHotel_Handler,
+		},
+		{
+			MethodName: "DeleteHotel",
+			Handler:    _HotelService_DeleteHotel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel.proto",
+}
@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: hotel/v1/room.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Room struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelId       string                 `protobuf:"bytes,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,5,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	Floor         int32                  `protobuf:"varint,6,opt,name=floor,proto3" json:"floor,omitempty"`
+	Number        int32                  `protobuf:"varint,7,opt,name=number,proto3" json:"number,omitempty"`
+	Name          string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_hotel_v1_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Room) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Room) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Room) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Room) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *Room) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *Room) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Room) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_hotel_v1_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *GetRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type ListRoomsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// Page size, defaults to 50 and cannot exceed 200
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// One of number, floor, created_at, prefixed with "-" for descending order
+	Sort          string  `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status        string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Floor         *int32  `protobuf:"varint,6,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	RoomTypeId    *string `protobuf:"bytes,7,opt,name=room_type_id,json=roomTypeId,proto3,oneof" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_hotel_v1_room_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRoomsRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *ListRoomsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRoomsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRoomsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListRoomsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRoomsRequest) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *ListRoomsRequest) GetRoomTypeId() string {
+	if x != nil && x.RoomTypeId != nil {
+		return *x.RoomTypeId
+	}
+	return ""
+}
+
+type ListRoomsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*Room                `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Empty on the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_hotel_v1_room_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRoomsResponse) GetResults() []*Room {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListRoomsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	Floor         int32                  `protobuf:"varint,3,opt,name=floor,proto3" json:"floor,omitempty"`
+	Number        int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_hotel_v1_room_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomTypeId    *string                `protobuf:"bytes,3,opt,name=room_type_id,json=roomTypeId,proto3,oneof" json:"room_type_id,omitempty"`
+	Floor         *int32                 `protobuf:"varint,4,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	Number        *int32                 `protobuf:"varint,5,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Status        *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_hotel_v1_room_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetRoomTypeId() string {
+	if x != nil && x.RoomTypeId != nil {
+		return *x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetNumber() int32 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_hotel_v1_room_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+var File_hotel_v1_room_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_room_proto_rawDesc = "" +
+	"\n" +
+	"\x13hotel/v1/room.proto\x12\bhotel.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x02\n" +
+	"\x04Room\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\tR\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x05 \x01(\tR\n" +
+	"roomTypeId\x12\x14\n" +
+	"\x05floor\x18\x06 \x01(\x05R\x05floor\x12\x16\n" +
+	"\x06number\x18\a \x01(\x05R\x06number\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"D\n" +
+	"\x0eGetRoomRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\"\xe4\x01\n" +
+	"\x10ListRoomsRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x19\n" +
+	"\x05floor\x18\x06 \x01(\x05H\x00R\x05floor\x88\x01\x01\x12%\n" +
+	"\froom_type_id\x18\a \x01(\tH\x01R\n" +
+	"roomTypeId\x88\x01\x01B\b\n" +
+	"\x06_floorB\x0f\n" +
+	"\r_room_type_id\"^\n" +
+	"\x11ListRoomsResponse\x12(\n" +
+	"\aresults\x18\x01 \x03(\v2\x0e.hotel.v1.RoomR\aresults\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xaa\x01\n" +
+	"\x11CreateRoomRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\tR\n" +
+	"roomTypeId\x12\x14\n" +
+	"\x05floor\x18\x03 \x01(\x05R\x05floor\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\x96\x02\n" +
+	"\x11UpdateRoomRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12%\n" +
+	"\froom_type_id\x18\x03 \x01(\tH\x00R\n" +
+	"roomTypeId\x88\x01\x01\x12\x19\n" +
+	"\x05floor\x18\x04 \x01(\x05H\x01R\x05floor\x88\x01\x01\x12\x1b\n" +
+	"\x06number\x18\x05 \x01(\x05H\x02R\x06number\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x03R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\a \x01(\tH\x04R\x06status\x88\x01\x01B\x0f\n" +
+	"\r_room_type_idB\b\n" +
+	"\x06_floorB\t\n" +
+	"\a_numberB\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_status\"G\n" +
+	"\x11DeleteRoomRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId2\xc1\x02\n" +
+	"\vRoomService\x123\n" +
+	"\aGetRoom\x12\x18.hotel.v1.GetRoomRequest\x1a\x0e.hotel.v1.Room\x12D\n" +
+	"\tListRooms\x12\x1a.hotel.v1.ListRoomsRequest\x1a\x1b.hotel.v1.ListRoomsResponse\x129\n" +
+	"\n" +
+	"CreateRoom\x12\x1b.hotel.v1.CreateRoomRequest\x1a\x0e.hotel.v1.Room\x129\n" +
+	"\n" +
+	"UpdateRoom\x12\x1b.hotel.v1.UpdateRoomRequest\x1a\x0e.hotel.v1.Room\x12A\n" +
+	"\n" +
+	"DeleteRoom\x12\x1b.hotel.v1.DeleteRoomRequest\x1a\x16.google.protobuf.EmptyB=Z;github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_room_proto_rawDescOnce sync.Once
+	file_hotel_v1_room_proto_rawDescData []byte
+)
+
+func file_hotel_v1_room_proto_rawDescGZIP() []byte {
+	file_hotel_v1_room_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_room_proto_rawDesc), len(file_hotel_v1_room_proto_rawDesc)))
+	})
+	return file_hotel_v1_room_proto_rawDescData
+}
+
+var file_hotel_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hotel_v1_room_proto_goTypes = []any{
+	(*Room)(nil),                  // 0: hotel.v1.Room
+	(*GetRoomRequest)(nil),        // 1: hotel.v1.GetRoomRequest
+	(*ListRoomsRequest)(nil),      // 2: hotel.v1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),     // 3: hotel.v1.ListRoomsResponse
+	(*CreateRoomRequest)(nil),     // 4: hotel.v1.CreateRoomRequest
+	(*UpdateRoomRequest)(nil),     // 5: hotel.v1.UpdateRoomRequest
+	(*DeleteRoomRequest)(nil),     // 6: hotel.v1.DeleteRoomRequest
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_hotel_v1_room_proto_depIdxs = []int32{
+	7, // 0: hotel.v1.Room.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: hotel.v1.Room.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: hotel.v1.ListRoomsResponse.results:type_name -> hotel.v1.Room
+	1, // 3: hotel.v1.RoomService.GetRoom:input_type -> hotel.v1.GetRoomRequest
+	2, // 4: hotel.v1.RoomService.ListRooms:input_type -> hotel.v1.ListRoomsRequest
+	4, // 5: hotel.v1.RoomService.CreateRoom:input_type -> hotel.v1.CreateRoomRequest
+	5, // 6: hotel.v1.RoomService.UpdateRoom:input_type -> hotel.v1.UpdateRoomRequest
+	6, // 7: hotel.v1.RoomService.DeleteRoom:input_type -> hotel.v1.DeleteRoomRequest
+	0, // 8: hotel.v1.RoomService.GetRoom:output_type -> hotel.v1.Room
+	3, // 9: hotel.v1.RoomService.ListRooms:output_type -> hotel.v1.ListRoomsResponse
+	0, // 10: hotel.v1.RoomService.CreateRoom:output_type -> hotel.v1.Room
+	0, // 11: hotel.v1.RoomService.UpdateRoom:output_type -> hotel.v1.Room
+	8, // 12: hotel.v1.RoomService.DeleteRoom:output_type -> google.protobuf.Empty
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_room_proto_init() }
+func file_hotel_v1_room_proto_init() {
+	if File_hotel_v1_room_proto != nil {
+		return
+	}
+	file_hotel_v1_room_proto_msgTypes[2].OneofWrappers = []any{}
+	file_hotel_v1_room_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_room_proto_rawDesc), len(file_hotel_v1_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hotel_v1_room_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_room_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_room_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_room_proto = out.File
+	file_hotel_v1_room_proto_goTypes = nil
+	file_hotel_v1_room_proto_depIdxs = nil
+}
@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: hotel/v1/room_type.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomType struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelId      string                 `protobuf:"bytes,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name         string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	NumberOfBeds int32                  `protobuf:"varint,7,opt,name=number_of_beds,json=numberOfBeds,proto3" json:"number_of_beds,omitempty"`
+	BedType      string                 `protobuf:"bytes,8,opt,name=bed_type,json=bedType,proto3" json:"bed_type,omitempty"`
+	MaxOccupancy int32                  `protobuf:"varint,9,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"`
+	// Decimal encoded as a string to keep its exact value, e.g. "120.50"
+	BasePrice     string `protobuf:"bytes,10,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomType) Reset() {
+	*x = RoomType{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomType) ProtoMessage() {}
+
+func (x *RoomType) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomType.ProtoReflect.Descriptor instead.
+func (*RoomType) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RoomType) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RoomType) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoomType) GetNumberOfBeds() int32 {
+	if x != nil {
+		return x.NumberOfBeds
+	}
+	return 0
+}
+
+func (x *RoomType) GetBedType() string {
+	if x != nil {
+		return x.BedType
+	}
+	return ""
+}
+
+func (x *RoomType) GetMaxOccupancy() int32 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+func (x *RoomType) GetBasePrice() string {
+	if x != nil {
+		return x.BasePrice
+	}
+	return ""
+}
+
+type GetRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomTypeRequest) Reset() {
+	*x = GetRoomTypeRequest{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomTypeRequest) ProtoMessage() {}
+
+func (x *GetRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *GetRoomTypeRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+type ListRoomTypesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// Page size, defaults to 50 and cannot exceed 200
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// One of name, base_price, max_occupancy, created_at, prefixed with "-"
+	// for descending order
+	Sort          string  `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	BedType       string  `protobuf:"bytes,5,opt,name=bed_type,json=bedType,proto3" json:"bed_type,omitempty"`
+	MinPrice      *string `protobuf:"bytes,6,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *string `protobuf:"bytes,7,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomTypesRequest) Reset() {
+	*x = ListRoomTypesRequest{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomTypesRequest) ProtoMessage() {}
+
+func (x *ListRoomTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomTypesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRoomTypesRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *ListRoomTypesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRoomTypesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRoomTypesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListRoomTypesRequest) GetBedType() string {
+	if x != nil {
+		return x.BedType
+	}
+	return ""
+}
+
+func (x *ListRoomTypesRequest) GetMinPrice() string {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return ""
+}
+
+func (x *ListRoomTypesRequest) GetMaxPrice() string {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return ""
+}
+
+type ListRoomTypesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*RoomType            `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Empty on the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomTypesResponse) Reset() {
+	*x = ListRoomTypesResponse{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomTypesResponse) ProtoMessage() {}
+
+func (x *ListRoomTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomTypesResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRoomTypesResponse) GetResults() []*RoomType {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListRoomTypesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	NumberOfBeds  int32                  `protobuf:"varint,4,opt,name=number_of_beds,json=numberOfBeds,proto3" json:"number_of_beds,omitempty"`
+	BedType       string                 `protobuf:"bytes,5,opt,name=bed_type,json=bedType,proto3" json:"bed_type,omitempty"`
+	MaxOccupancy  int32                  `protobuf:"varint,6,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"`
+	BasePrice     string                 `protobuf:"bytes,7,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomTypeRequest) Reset() {
+	*x = CreateRoomTypeRequest{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomTypeRequest) ProtoMessage() {}
+
+func (x *CreateRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetNumberOfBeds() int32 {
+	if x != nil {
+		return x.NumberOfBeds
+	}
+	return 0
+}
+
+func (x *CreateRoomTypeRequest) GetBedType() string {
+	if x != nil {
+		return x.BedType
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetMaxOccupancy() int32 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+func (x *CreateRoomTypeRequest) GetBasePrice() string {
+	if x != nil {
+		return x.BasePrice
+	}
+	return ""
+}
+
+type UpdateRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	NumberOfBeds  *int32                 `protobuf:"varint,5,opt,name=number_of_beds,json=numberOfBeds,proto3,oneof" json:"number_of_beds,omitempty"`
+	BedType       *string                `protobuf:"bytes,6,opt,name=bed_type,json=bedType,proto3,oneof" json:"bed_type,omitempty"`
+	MaxOccupancy  *int32                 `protobuf:"varint,7,opt,name=max_occupancy,json=maxOccupancy,proto3,oneof" json:"max_occupancy,omitempty"`
+	BasePrice     *string                `protobuf:"bytes,8,opt,name=base_price,json=basePrice,proto3,oneof" json:"base_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomTypeRequest) Reset() {
+	*x = UpdateRoomTypeRequest{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomTypeRequest) ProtoMessage() {}
+
+func (x *UpdateRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetNumberOfBeds() int32 {
+	if x != nil && x.NumberOfBeds != nil {
+		return *x.NumberOfBeds
+	}
+	return 0
+}
+
+func (x *UpdateRoomTypeRequest) GetBedType() string {
+	if x != nil && x.BedType != nil {
+		return *x.BedType
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetMaxOccupancy() int32 {
+	if x != nil && x.MaxOccupancy != nil {
+		return *x.MaxOccupancy
+	}
+	return 0
+}
+
+func (x *UpdateRoomTypeRequest) GetBasePrice() string {
+	if x != nil && x.BasePrice != nil {
+		return *x.BasePrice
+	}
+	return ""
+}
+
+type DeleteRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,2,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomTypeRequest) Reset() {
+	*x = DeleteRoomTypeRequest{}
+	mi := &file_hotel_v1_room_type_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomTypeRequest) ProtoMessage() {}
+
+func (x *DeleteRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_room_type_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_room_type_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteRoomTypeRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+var File_hotel_v1_room_type_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_room_type_proto_rawDesc = "" +
+	"\n" +
+	"\x18hotel/v1/room_type.proto\x12\bhotel.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x02\n" +
+	"\bRoomType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\tR\ahotelId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12$\n" +
+	"\x0enumber_of_beds\x18\a \x01(\x05R\fnumberOfBeds\x12\x19\n" +
+	"\bbed_type\x18\b \x01(\tR\abedType\x12#\n" +
+	"\rmax_occupancy\x18\t \x01(\x05R\fmaxOccupancy\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\n" +
+	" \x01(\tR\tbasePrice\"Q\n" +
+	"\x12GetRoomTypeRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\tR\n" +
+	"roomTypeId\"\xee\x01\n" +
+	"\x14ListRoomTypesRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x19\n" +
+	"\bbed_type\x18\x05 \x01(\tR\abedType\x12 \n" +
+	"\tmin_price\x18\x06 \x01(\tH\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\a \x01(\tH\x01R\bmaxPrice\x88\x01\x01B\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"f\n" +
+	"\x15ListRoomTypesResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.hotel.v1.RoomTypeR\aresults\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xed\x01\n" +
+	"\x15CreateRoomTypeRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\x0enumber_of_beds\x18\x04 \x01(\x05R\fnumberOfBeds\x12\x19\n" +
+	"\bbed_type\x18\x05 \x01(\tR\abedType\x12#\n" +
+	"\rmax_occupancy\x18\x06 \x01(\x05R\fmaxOccupancy\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\a \x01(\tR\tbasePrice\"\x87\x03\n" +
+	"\x15UpdateRoomTypeRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\tR\n" +
+	"roomTypeId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12)\n" +
+	"\x0enumber_of_beds\x18\x05 \x01(\x05H\x02R\fnumberOfBeds\x88\x01\x01\x12\x1e\n" +
+	"\bbed_type\x18\x06 \x01(\tH\x03R\abedType\x88\x01\x01\x12(\n" +
+	"\rmax_occupancy\x18\a \x01(\x05H\x04R\fmaxOccupancy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"base_price\x18\b \x01(\tH\x05R\tbasePrice\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_number_of_bedsB\v\n" +
+	"\t_bed_typeB\x10\n" +
+	"\x0e_max_occupancyB\r\n" +
+	"\v_base_price\"T\n" +
+	"\x15DeleteRoomTypeRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12 \n" +
+	"\froom_type_id\x18\x02 \x01(\tR\n" +
+	"roomTypeId2\xfd\x02\n" +
+	"\x0fRoomTypeService\x12?\n" +
+	"\vGetRoomType\x12\x1c.hotel.v1.GetRoomTypeRequest\x1a\x12.hotel.v1.RoomType\x12P\n" +
+	"\rListRoomTypes\x12\x1e.hotel.v1.ListRoomTypesRequest\x1a\x1f.hotel.v1.ListRoomTypesResponse\x12E\n" +
+	"\x0eCreateRoomType\x12\x1f.hotel.v1.CreateRoomTypeRequest\x1a\x12.hotel.v1.RoomType\x12E\n" +
+	"\x0eUpdateRoomType\x12\x1f.hotel.v1.UpdateRoomTypeRequest\x1a\x12.hotel.v1.RoomType\x12I\n" +
+	"\x0eDeleteRoomType\x12\x1f.hotel.v1.DeleteRoomTypeRequest\x1a\x16.google.protobuf.EmptyB=Z;github.com/sebenitezg/hotel-service/pkg/pb/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_room_type_proto_rawDescOnce sync.Once
+	file_hotel_v1_room_type_proto_rawDescData []byte
+)
+
+func file_hotel_v1_room_type_proto_rawDescGZIP() []byte {
+	file_hotel_v1_room_type_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_room_type_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_room_type_proto_rawDesc), len(file_hotel_v1_room_type_proto_rawDesc)))
+	})
+	return file_hotel_v1_room_type_proto_rawDescData
+}
+
+var file_hotel_v1_room_type_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hotel_v1_room_type_proto_goTypes = []any{
+	(*RoomType)(nil),              // 0: hotel.v1.RoomType
+	(*GetRoomTypeRequest)(nil),    // 1: hotel.v1.GetRoomTypeRequest
+	(*ListRoomTypesRequest)(nil),  // 2: hotel.v1.ListRoomTypesRequest
+	(*ListRoomTypesResponse)(nil), // 3: hotel.v1.ListRoomTypesResponse
+	(*CreateRoomTypeRequest)(nil), // 4: hotel.v1.CreateRoomTypeRequest
+	(*UpdateRoomTypeRequest)(nil), // 5: hotel.v1.UpdateRoomTypeRequest
+	(*DeleteRoomTypeRequest)(nil), // 6: hotel.v1.DeleteRoomTypeRequest
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_hotel_v1_room_type_proto_depIdxs = []int32{
+	7, // 0: hotel.v1.RoomType.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: hotel.v1.RoomType.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: hotel.v1.ListRoomTypesResponse.results:type_name -> hotel.v1.RoomType
+	1, // 3: hotel.v1.RoomTypeService.GetRoomType:input_type -> hotel.v1.GetRoomTypeRequest
+	2, // 4: hotel.v1.RoomTypeService.ListRoomTypes:input_type -> hotel.v1.ListRoomTypesRequest
+	4, // 5: hotel.v1.RoomTypeService.CreateRoomType:input_type -> hotel.v1.CreateRoomTypeRequest
+	5, // 6: hotel.v1.RoomTypeService.UpdateRoomType:input_type -> hotel.v1.UpdateRoomTypeRequest
+	6, // 7: hotel.v1.RoomTypeService.DeleteRoomType:input_type -> hotel.v1.DeleteRoomTypeRequest
+	0, // 8: hotel.v1.RoomTypeService.GetRoomType:output_type -> hotel.v1.RoomType
+	3, // 9: hotel.v1.RoomTypeService.ListRoomTypes:output_type -> hotel.v1.ListRoomTypesResponse
+	0, // 10: hotel.v1.RoomTypeService.CreateRoomType:output_type -> hotel.v1.RoomType
+	0, // 11: hotel.v1.RoomTypeService.UpdateRoomType:output_type -> hotel.v1.RoomType
+	8, // 12: hotel.v1.RoomTypeService.DeleteRoomType:output_type -> google.protobuf.Empty
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_room_type_proto_init() }
+func file_hotel_v1_room_type_proto_init() {
+	if File_hotel_v1_room_type_proto != nil {
+		return
+	}
+	file_hotel_v1_room_type_proto_msgTypes[2].OneofWrappers = []any{}
+	file_hotel_v1_room_type_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_room_type_proto_rawDesc), len(file_hotel_v1_room_type_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hotel_v1_room_type_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_room_type_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_room_type_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_room_type_proto = out.File
+	file_hotel_v1_room_type_proto_goTypes = nil
+	file_hotel_v1_room_type_proto_depIdxs = nil
+}
@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: hotel/v1/room_type.proto
+
+package hotelv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RoomTypeService_GetRoomType_FullMethodName    = "/hotel.v1.RoomTypeService/GetRoomType"
+	RoomTypeService_ListRoomTypes_FullMethodName  = "/hotel.v1.RoomTypeService/ListRoomTypes"
+	RoomTypeService_CreateRoomType_FullMethodName = "/hotel.v1.RoomTypeService/CreateRoomType"
+	RoomTypeService_UpdateRoomType_FullMethodName = "/hotel.v1.RoomTypeService/UpdateRoomType"
+	RoomTypeService_DeleteRoomType_FullMethodName = "/hotel.v1.RoomTypeService/DeleteRoomType"
+)
+
+// RoomTypeServiceClient is the client API for RoomTypeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RoomTypeService mirrors the /v1/hotels/{hotel_id}/roomtypes REST endpoints.
+type RoomTypeServiceClient interface {
+	GetRoomType(ctx context.Context, in *GetRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	ListRoomTypes(ctx context.Context, in *ListRoomTypesRequest, opts ...grpc.CallOption) (*ListRoomTypesResponse, error)
+	CreateRoomType(ctx context.Context, in *CreateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	UpdateRoomType(ctx context.Context, in *UpdateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	DeleteRoomType(ctx context.Context, in *DeleteRoomTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type roomTypeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomTypeServiceClient(cc grpc.ClientConnInterface) RoomTypeServiceClient {
+	return &roomTypeServiceClient{cc}
+}
+
+func (c *roomTypeServiceClient) GetRoomType(ctx context.Context, in *GetRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, RoomTypeService_GetRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomTypeServiceClient) ListRoomTypes(ctx context.Context, in *ListRoomTypesRequest, opts ...grpc.CallOption) (*ListRoomTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomTypesResponse)
+	err := c.cc.Invoke(ctx, RoomTypeService_ListRoomTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomTypeServiceClient) CreateRoomType(ctx context.Context, in *CreateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, RoomTypeService_CreateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomTypeServiceClient) UpdateRoomType(ctx context.Context, in *UpdateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, RoomTypeService_UpdateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomTypeServiceClient) DeleteRoomType(ctx context.Context, in *DeleteRoomTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoomTypeService_DeleteRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomTypeServiceServer is the server API for RoomTypeService service.
+// All implementations must embed UnimplementedRoomTypeServiceServer
+// for forward compatibility.
+//
+// RoomTypeService mirrors the /v1/hotels/{hotel_id}/roomtypes REST endpoints.
+type RoomTypeServiceServer interface {
+	GetRoomType(context.Context, *GetRoomTypeRequest) (*RoomType, error)
+	ListRoomTypes(context.Context, *ListRoomTypesRequest) (*ListRoomTypesResponse, error)
+	CreateRoomType(context.Context, *CreateRoomTypeRequest) (*RoomType, error)
+	UpdateRoomType(context.Context, *UpdateRoomTypeRequest) (*RoomType, error)
+	DeleteRoomType(context.Context, *DeleteRoomTypeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRoomTypeServiceServer()
+}
+
+// UnimplementedRoomTypeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomTypeServiceServer struct{}
+
+func (UnimplementedRoomTypeServiceServer) GetRoomType(context.Context, *GetRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomType not implemented")
+}
+func (UnimplementedRoomTypeServiceServer) ListRoomTypes(context.Context, *ListRoomTypesRequest) (*ListRoomTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomTypes not implemented")
+}
+func (UnimplementedRoomTypeServiceServer) CreateRoomType(context.Context, *CreateRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoomType not implemented")
+}
+func (UnimplementedRoomTypeServiceServer) UpdateRoomType(context.Context, *UpdateRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomType not implemented")
+}
+func (UnimplementedRoomTypeServiceServer) DeleteRoomType(context.Context, *DeleteRoomTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoomType not implemented")
+}
+func (UnimplementedRoomTypeServiceServer) mustEmbedUnimplementedRoomTypeServiceServer() {}
+func (UnimplementedRoomTypeServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRoomTypeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomTypeServiceServer will
+// result in compilation errors.
+type UnsafeRoomTypeServiceServer interface {
+	mustEmbedUnimplementedRoomTypeServiceServer()
+}
+
+func RegisterRoomTypeServiceServer(s grpc.ServiceRegistrar, srv RoomTypeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoomTypeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomTypeService_ServiceDesc, srv)
+}
+
+func _RoomTypeService_GetRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTypeServiceServer).GetRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTypeService_GetRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTypeServiceServer).GetRoomType(ctx, req.(*GetRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomTypeService_ListRoomTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTypeServiceServer).ListRoomTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTypeService_ListRoomTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTypeServiceServer).ListRoomTypes(ctx, req.(*ListRoomTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomTypeService_CreateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTypeServiceServer).CreateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTypeService_CreateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTypeServiceServer).CreateRoomType(ctx, req.(*CreateRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomTypeService_UpdateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTypeServiceServer).UpdateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTypeService_UpdateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTypeServiceServer).UpdateRoomType(ctx, req.(*UpdateRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomTypeService_DeleteRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTypeServiceServer).DeleteRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTypeService_DeleteRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTypeServiceServer).DeleteRoomType(ctx, req.(*DeleteRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomTypeService_ServiceDesc is the grpc.ServiceDesc for RoomTypeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomTypeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.RoomTypeService",
+	HandlerType: (*RoomTypeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoomType",
+			Handler:    _RoomTypeService_GetRoomType_Handler,
+		},
+		{
+			MethodName: "ListRoomTypes",
+			Handler:    _RoomTypeService_ListRoomTypes_Handler,
+		},
+		{
+			MethodName: "CreateRoomType",
+			Handler:    _RoomTypeService_CreateRoomType_Handler,
+		},
+		{
+			MethodName: "UpdateRoomType",
+			Handler:    _RoomTypeService_UpdateRoomType_Handler,
+		},
+		{
+			MethodName: "DeleteRoomType",
+			Handler:    _RoomTypeService_DeleteRoomType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/room_type.proto",
+}
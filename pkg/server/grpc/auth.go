@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+
+	"github.com/monzo/terrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	ErrMissingToken     = terrors.Unauthorized("token", "missing bearer token", nil)
+	ErrMethodNotAllowed = terrors.Forbidden("method", "method does not declare the roles it requires", nil)
+)
+
+// TokenVerifier verifies bearer tokens, returning the principal they
+// authenticate
+type TokenVerifier interface {
+	Verify(ctx context.Context, rawToken string) (*auth.Principal, error)
+}
+
+// RequireRoles Declares the roles the principal calling a method must hold.
+// Methods that declare none are rejected, so RPCs added without a policy are
+// never exposed unauthenticated.
+func (s *GRPCServer) RequireRoles(fullMethod string, roles ...string) {
+	s.methodRoles[fullMethod] = roles
+}
+
+// authenticate Rejects unary calls without a valid bearer token in their
+// authorization metadata or whose principal lacks the roles or the hotel
+// membership of the method, equivalent to middleware.Authenticate,
+// middleware.RequireRoles and middleware.RequireHotelMember of the http
+// server. The principal is stored in the context of the call.
+func (s *GRPCServer) authenticate(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	roles, ok := s.methodRoles[info.FullMethod]
+	if !ok {
+		return nil, Error(ErrMethodNotAllowed)
+	}
+
+	var (
+		rawToken string
+		found    bool
+	)
+	if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+		rawToken, found = strings.CutPrefix(values[0], "Bearer ")
+	}
+	if !found || rawToken == "" {
+		return nil, Error(ErrMissingToken)
+	}
+
+	principal, err := s.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, Error(err)
+	}
+
+	for _, role := range roles {
+		if !principal.HasRole(role) {
+			return nil, Error(terrors.Forbidden(
+				"role", fmt.Sprintf("missing required role %s", role), nil,
+			))
+		}
+	}
+
+	ctx = auth.WithPrincipal(ctx, principal)
+	if err := s.authorizeHotel(ctx, info.FullMethod, principal, req); err != nil {
+		return nil, Error(err)
+	}
+
+	return handler(ctx, req)
+}
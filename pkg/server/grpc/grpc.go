@@ -1 +1,102 @@
 package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer gRPC server
+type GRPCServer struct {
+	sc            config.ServerConfigurations
+	Server        *grpc.Server
+	verifier      TokenVerifier
+	methodRoles   map[string][]string
+	hotelPolicies map[string]hotelPolicy
+}
+
+func NewGRPCServer(serverConf config.ServerConfigurations, verifier TokenVerifier) *GRPCServer {
+	s := &GRPCServer{
+		sc:            serverConf,
+		verifier:      verifier,
+		methodRoles:   map[string][]string{},
+		hotelPolicies: map[string]hotelPolicy{},
+	}
+
+	s.Server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoverer, s.authenticate),
+	)
+
+	// Allows tools such as grpcurl to discover the exposed services. It is
+	// a streaming service the authentication does not cover, so it is only
+	// registered in debug mode.
+	if serverConf.DebugMode {
+		reflection.Register(s.Server)
+	}
+
+	return s
+}
+
+// Start Serves requests until the server is shut down, it only returns an
+// error when the server could not be started or failed
+func (s *GRPCServer) Start() error {
+	listeningAddr := ":" + s.sc.GRPCPort
+	log.Printf("gRPC server listening on port %s", listeningAddr)
+
+	listener, err := net.Listen("tcp", listeningAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on grpc port: %w", err)
+	}
+
+	// Start the server
+	err = s.Server.Serve(listener)
+	if err != nil {
+		return fmt.Errorf("failed to start grpc server: %w", err)
+	}
+	return nil
+}
+
+// Shutdown Stops accepting connections and waits for the in-flight calls to
+// complete. Calls still running when ctx is done are cancelled.
+func (s *GRPCServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Server.Stop()
+		return ctx.Err()
+	}
+}
+
+// recoverer Recovers from panics in unary handlers, equivalent to chi's
+// middleware.Recoverer for the http server.
+func recoverer(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer func() {
+		if rvr := recover(); rvr != nil {
+			logger.GetLogger().Errorw("panic serving grpc request", "method", info.FullMethod, "panic", rvr)
+			err = status.Error(codes.Internal, "something went wrong, please try again later")
+		}
+	}()
+
+	return handler(ctx, req)
+}
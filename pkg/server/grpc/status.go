@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+
+	"github.com/monzo/terrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error Converts an error into a gRPC status error with the same sane
+// defaults used by rest.RenderError.
+func Error(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	// Check if the error is a terrors.Error
+	var terror *terrors.Error
+	if !errors.As(err, &terror) {
+		return status.Error(codes.Internal, "something went wrong, please try again later")
+	}
+
+	parsedCode := strings.Split(terror.Code, ".")
+
+	switch parsedCode[0] {
+	case terrors.ErrUnauthorized:
+		return status.Error(codes.Unauthenticated, terror.Message)
+	case terrors.ErrForbidden:
+		return status.Error(codes.PermissionDenied, terror.Message)
+	case terrors.ErrNotFound:
+		return status.Error(codes.NotFound, terror.Message)
+	case terrors.ErrPreconditionFailed:
+		return status.Error(codes.FailedPrecondition, terror.Message)
+	case terrors.ErrBadRequest:
+		return status.Error(codes.InvalidArgument, terror.Message)
+	case rest.ErrConflict:
+		return status.Error(codes.FailedPrecondition, terror.Message)
+	}
+
+	return status.Error(codes.Internal, "something went wrong, please try again later")
+}
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// hotelScopedRequest request of a method operating on a single hotel
+type hotelScopedRequest interface {
+	GetHotelId() string
+}
+
+// hotelPolicy membership a method requires in the hotel of its request
+type hotelPolicy struct {
+	checker    auth.HotelMembershipChecker
+	hotelRoles []string
+}
+
+// RequireHotelMember Declares that the principal calling a method must be a
+// member of the hotel of the request's hotel_id, equivalent to
+// middleware.RequireHotelMember of the http server. When hotelRoles are
+// given the membership must hold one of them. Administrators are always
+// allowed.
+func (s *GRPCServer) RequireHotelMember(fullMethod string, checker auth.HotelMembershipChecker, hotelRoles ...string) {
+	s.hotelPolicies[fullMethod] = hotelPolicy{checker: checker, hotelRoles: hotelRoles}
+}
+
+// authorizeHotel Rejects calls whose principal does not hold the membership
+// the method requires in the hotel of the request
+func (s *GRPCServer) authorizeHotel(ctx context.Context, fullMethod string, principal *auth.Principal, req any) error {
+	policy, ok := s.hotelPolicies[fullMethod]
+	if !ok || principal.IsAdmin() {
+		return nil
+	}
+
+	scoped, ok := req.(hotelScopedRequest)
+	if !ok {
+		return auth.ErrHotelNotFound
+	}
+	hotelID, err := uuid.FromString(scoped.GetHotelId())
+	if err != nil {
+		return auth.ErrHotelNotFound
+	}
+
+	return auth.AuthorizeHotel(ctx, policy.checker, principal, hotelID, policy.hotelRoles...)
+}
@@ -0,0 +1,17 @@
+package rest
+
+import "github.com/monzo/terrors"
+
+// ErrConflict terrors code of operations conflicting with the current state
+// of a resource, transports render it as a conflict.
+const ErrConflict = "conflict"
+
+// Conflict Returns a terrors.Error with the ErrConflict code
+func Conflict(code, message string, params map[string]string) *terrors.Error {
+	if code != "" {
+		code = ErrConflict + "." + code
+	} else {
+		code = ErrConflict
+	}
+	return terrors.New(code, message, params)
+}
@@ -1,27 +1,46 @@
 package rest
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/riandyrn/otelchi"
 
 	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/health"
+	"github.com/sebenitezg/hotel-service/pkg/metrics"
+	"github.com/sebenitezg/hotel-service/pkg/tracing"
 )
 
-// HTTPServer http server
+// HTTPServer http server. The /healthz and /readyz probes and /metrics are
+// served outside of Router, so they skip its middlewares such as
+// authentication.
 type HTTPServer struct {
 	sc     config.ServerConfigurations
 	Router *chi.Mux
+	Health *health.Checker
+	server *http.Server
 }
 
 func NewHTTPServer(serverConf config.ServerConfigurations) *HTTPServer {
 	router := chi.NewRouter()
 
-	// APM middleware
-	//router.Use(apmchiv5.Middleware())
+	// Spans of the requests named after their route pattern, continuing the
+	// W3C trace context of the caller
+	router.Use(otelchi.Middleware(
+		tracing.ServiceName,
+		otelchi.WithChiRoutes(router),
+		otelchi.WithRequestMethodInSpanName(true),
+	))
+
+	// Request counts and latencies exposed on /metrics
+	router.Use(metrics.Middleware)
 
 	// A good base middleware stack
 	router.Use(middleware.AllowContentType("application/json"))
@@ -34,27 +53,56 @@ func NewHTTPServer(serverConf config.ServerConfigurations) *HTTPServer {
 	// processing should be stopped.
 	router.Use(middleware.Timeout(60 * time.Second))
 
+	checker := health.NewChecker()
+
+	root := chi.NewRouter()
+	root.Get("/healthz", checker.HandleLiveness)
+	root.Get("/readyz", checker.HandleReadiness)
+	root.Handle("/metrics", metrics.Handler())
+	root.Mount("/", router)
+
+	// Customizing the server
+	server := &http.Server{
+		Addr:         ":" + serverConf.Port,
+		Handler:      root,
+		ReadTimeout:  20 * time.Second,
+		WriteTimeout: 20 * time.Second,
+	}
+
 	return &HTTPServer{
 		sc:     serverConf,
 		Router: router,
+		Health: checker,
+		server: server,
 	}
 }
 
-func (r *HTTPServer) Start() {
-	listeningAddr := ":" + r.sc.Port
-	log.Printf("Server listening on port %s", listeningAddr)
+// Start Serves requests until the server is shut down, it only returns an
+// error when the server could not be started or failed
+func (r *HTTPServer) Start() error {
+	log.Printf("Server listening on port %s", r.server.Addr)
 
-	// Customizing the server
-	s := &http.Server{
-		Addr:         listeningAddr,
-		Handler:      r.Router,
-		ReadTimeout:  20 * time.Second,
-		WriteTimeout: 20 * time.Second,
+	// Start the server
+	err := r.server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to start http server: %w", err)
 	}
+	return nil
+}
 
-	// Start the server
-	err := s.ListenAndServe()
-	if err != nil {
-		log.Fatalf("Failed to start http server. %v", err)
+// Shutdown Fails readiness right away and keeps serving for the drain delay
+// so load balancers stop routing traffic to the server, then stops
+// accepting connections and waits for the in-flight requests to complete
+// until ctx is done
+func (r *HTTPServer) Shutdown(ctx context.Context) error {
+	r.Health.Drain()
+
+	if r.sc.DrainDelay > 0 {
+		select {
+		case <-time.After(r.sc.DrainDelay):
+		case <-ctx.Done():
+		}
 	}
+
+	return r.server.Shutdown(ctx)
 }
@@ -1 +1,161 @@
 package middleware
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sebenitezg/hotel-service/config"
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/logger"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/monzo/terrors"
+)
+
+var (
+	ErrMissingToken = terrors.Unauthorized("token", "missing bearer token", nil)
+	ErrInvalidToken = terrors.Unauthorized("token", "invalid or expired bearer token", nil)
+)
+
+// Claims JWT claims accepted by the service
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// Authenticator validates HS256/RS256 bearer tokens and stores the
+// resulting Principal in the request context.
+type Authenticator struct {
+	parser     *jwt.Parser
+	hmacSecret []byte
+	rsaKey     *rsa.PublicKey
+	jwks       map[string]*rsa.PublicKey
+}
+
+func NewAuthenticator(authConf config.AuthConfigurations) (*Authenticator, error) {
+	a := &Authenticator{}
+
+	if authConf.HMACSecret != "" {
+		a.hmacSecret = []byte(authConf.HMACSecret)
+	}
+
+	if authConf.RSAPublicKey != "" {
+		key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(authConf.RSAPublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("parsing rsa public key: %w", err)
+		}
+		a.rsaKey = key
+	}
+
+	if authConf.JWKSFile != "" {
+		keys, err := loadJWKSFile(authConf.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading jwks file: %w", err)
+		}
+		a.jwks = keys
+	}
+
+	if a.hmacSecret == nil && a.rsaKey == nil && len(a.jwks) == 0 {
+		return nil, errors.New("no token verification key configured")
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if authConf.Issuer != "" {
+		options = append(options, jwt.WithIssuer(authConf.Issuer))
+	}
+	if authConf.Audience != "" {
+		options = append(options, jwt.WithAudience(authConf.Audience))
+	}
+	a.parser = jwt.NewParser(options...)
+
+	return a, nil
+}
+
+// Authenticate Rejects requests without a valid bearer token
+func (a *Authenticator) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		rawToken, found := strings.CutPrefix(header, "Bearer ")
+		if !found || rawToken == "" {
+			rest.RenderError(r.Context(), w, ErrMissingToken)
+			return
+		}
+
+		principal, err := a.Verify(r.Context(), rawToken)
+		if err != nil {
+			rest.RenderError(r.Context(), w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// Verify Returns the principal authenticated by a bearer token, shared by
+// the http and gRPC servers
+func (a *Authenticator) Verify(ctx context.Context, rawToken string) (*auth.Principal, error) {
+	var claims Claims
+	_, err := a.parser.ParseWithClaims(rawToken, &claims, a.keyFunc)
+	if err != nil {
+		logger.WithContext(ctx).Infow("rejected bearer token", "error", err)
+		return nil, ErrInvalidToken
+	}
+
+	return &auth.Principal{
+		Subject: claims.Subject,
+		Roles:   claims.Roles,
+	}, nil
+}
+
+// RequireRoles Rejects requests whose principal lacks any of the roles
+func RequireRoles(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := auth.PrincipalFromContext(r.Context())
+			if !ok {
+				rest.RenderError(r.Context(), w, ErrMissingToken)
+				return
+			}
+
+			for _, role := range roles {
+				if !principal.HasRole(role) {
+					rest.RenderError(r.Context(), w, terrors.Forbidden(
+						"role", fmt.Sprintf("missing required role %s", role), nil,
+					))
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (a *Authenticator) keyFunc(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if a.hmacSecret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return a.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		if kid, ok := token.Header["kid"].(string); ok {
+			if key, ok := a.jwks[kid]; ok {
+				return key, nil
+			}
+		}
+		if a.rsaKey == nil {
+			return nil, errors.New("no rsa key matches the token")
+		}
+		return a.rsaKey, nil
+	}
+	return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+
+	"github.com/monzo/terrors"
+)
+
+var ErrIncludeDeletedDenied = terrors.Forbidden("include_deleted", "only administrators can read deleted records", nil)
+
+// IncludeDeleted Reports whether the request asks for soft deleted records
+// through ?include_deleted=true, which is reserved to administrators.
+func IncludeDeleted(r *http.Request) (bool, error) {
+	if r.URL.Query().Get("include_deleted") != "true" {
+		return false, nil
+	}
+
+	principal, ok := auth.PrincipalFromContext(r.Context())
+	if !ok || !principal.IsAdmin() {
+		return false, ErrIncludeDeletedDenied
+	}
+	return true, nil
+}
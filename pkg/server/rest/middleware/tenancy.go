@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/sebenitezg/hotel-service/pkg/auth"
+	"github.com/sebenitezg/hotel-service/pkg/server/rest"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid/v5"
+)
+
+// RequireHotelMember Rejects requests on {hotel_id} scoped routes whose
+// principal is not a member of the hotel. When hotelRoles are given the
+// membership must hold one of them. Administrators are always allowed.
+func RequireHotelMember(checker auth.HotelMembershipChecker, hotelRoles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := auth.PrincipalFromContext(r.Context())
+			if !ok {
+				rest.RenderError(r.Context(), w, ErrMissingToken)
+				return
+			}
+
+			if principal.IsAdmin() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			hotelID, err := uuid.FromString(chi.URLParam(r, "hotel_id"))
+			if err != nil {
+				rest.RenderError(r.Context(), w, auth.ErrHotelNotFound)
+				return
+			}
+
+			err = auth.AuthorizeHotel(r.Context(), checker, principal, hotelID, hotelRoles...)
+			if err != nil {
+				rest.RenderError(r.Context(), w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
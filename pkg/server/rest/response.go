@@ -3,6 +3,7 @@ package rest
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -28,6 +29,16 @@ func RenderJSON(ctx context.Context, w http.ResponseWriter, httpStatusCode int,
 	_, _ = w.Write(js)
 }
 
+// RenderFile Renders a document to be downloaded as filename
+func RenderFile(ctx context.Context, w http.ResponseWriter, contentType string, filename string, content []byte) {
+	w.Header().Set(middleware.RequestIDHeader, middleware.GetReqID(ctx))
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
 // RenderError Renders an error with some sane defaults.
 func RenderError(ctx context.Context, w http.ResponseWriter, err error) {
 	httpStatusCode := http.StatusInternalServerError
@@ -63,6 +74,10 @@ func RenderError(ctx context.Context, w http.ResponseWriter, err error) {
 		httpStatusCode = http.StatusBadRequest
 		code = "bad_request"
 		message = terror.Message
+	case ErrConflict:
+		httpStatusCode = http.StatusConflict
+		code = "conflict"
+		message = terror.Message
 	}
 
 	payload := map[string]string{
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryHook bun query hook tracing every query as a child span of the span
+// of its context
+type QueryHook struct {
+	dbName string
+}
+
+var _ bun.QueryHook = (*QueryHook)(nil)
+
+func NewQueryHook(dbName string) *QueryHook {
+	return &QueryHook{dbName: dbName}
+}
+
+func (h *QueryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	ctx, _ = otel.Tracer(instrumentationName).Start(
+		ctx,
+		event.Operation(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(event.StartTime),
+	)
+	return ctx
+}
+
+func (h *QueryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.DBNamespace(h.dbName),
+		semconv.DBOperationName(event.Operation()),
+		semconv.DBQueryText(event.Query),
+	)
+	// Lookups of missing rows are expected and not errors of the query
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		Fail(span, event.Err)
+	}
+}
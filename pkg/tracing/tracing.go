@@ -0,0 +1,148 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/sebenitezg/hotel-service/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName service.name of the spans unless OTEL_SERVICE_NAME is set
+const ServiceName = "hotel-service"
+
+// instrumentationName name of the tracer of the spans started by the service
+const instrumentationName = "github.com/sebenitezg/hotel-service"
+
+// flushTimeout longest Close waits for the pending spans to be exported
+const flushTimeout = 5 * time.Second
+
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Provider exports the spans of the service
+type Provider struct {
+	provider *sdktrace.TracerProvider
+	output   io.Closer
+}
+
+// NewProvider Registers the W3C trace context and baggage propagators and,
+// unless the exporter is none, a tracer provider exporting the spans with
+// the configured exporter. Incoming trace contexts are propagated even when
+// spans are not exported, so logs still carry the caller's trace ID.
+func NewProvider(conf config.TracingConfigurations) (*Provider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	p := &Provider{}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch conf.Exporter {
+	case "", ExporterNone:
+		return p, nil
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+		if conf.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterFile:
+		var file *os.File
+		file, err = os.OpenFile(conf.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open traces file: %w", err)
+		}
+		p.output = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", conf.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", conf.Exporter, err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(ServiceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES win
+	res, err = resource.Merge(res, resource.Environment())
+	if err != nil {
+		return nil, err
+	}
+
+	// Every new trace is sampled unless a ratio is set, traces started by
+	// callers follow their sampling decision
+	ratio := conf.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	p.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(p.provider)
+
+	return p, nil
+}
+
+// Close Exports the pending spans and stops the exporter
+func (p *Provider) Close() error {
+	if p.provider == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+
+	err := p.provider.Shutdown(ctx)
+	if p.output != nil {
+		if closeErr := p.output.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Start Starts a span named after the operation as a child of the span of
+// ctx, the returned context carries the new span
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Fail Records err on the span and marks the span as failed, it returns err
+// so failure paths can return it right away
+func Fail(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
@@ -0,0 +1,28 @@
+-- migrate:up
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE public.reservations (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    guest_name VARCHAR(128) NOT NULL,
+    guest_email VARCHAR(256) NOT NULL,
+    guests INTEGER NOT NULL,
+    check_in DATE NOT NULL,
+    check_out DATE NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    CONSTRAINT reservations_stay_dates_check CHECK (check_out > check_in),
+    -- Two active reservations can never share a night of the same room,
+    -- the database enforces it even under concurrent bookings.
+    CONSTRAINT reservations_room_stay_excl EXCLUDE USING gist (
+        room_id WITH =,
+        daterange(check_in, check_out, '[)') WITH &&
+    ) WHERE (status <> 'cancelled')
+);
+
+CREATE INDEX reservations_hotel_id_idx ON public.reservations (hotel_id);
+
+-- migrate:down
+DROP TABLE public.reservations;
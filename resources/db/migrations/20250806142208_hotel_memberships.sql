@@ -0,0 +1,13 @@
+-- migrate:up
+CREATE TABLE public.hotel_memberships (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    principal VARCHAR(256) NOT NULL,
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    role VARCHAR(32) NOT NULL,
+    CONSTRAINT hotel_memberships_principal_hotel_key UNIQUE (principal, hotel_id)
+);
+
+-- migrate:down
+DROP TABLE public.hotel_memberships;
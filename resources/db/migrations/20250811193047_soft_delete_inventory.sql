@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE public.hotels ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE public.room_types ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE public.rooms ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- migrate:down
+ALTER TABLE public.rooms DROP COLUMN deleted_at;
+ALTER TABLE public.room_types DROP COLUMN deleted_at;
+ALTER TABLE public.hotels DROP COLUMN deleted_at;
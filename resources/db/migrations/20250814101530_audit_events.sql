@@ -0,0 +1,18 @@
+-- migrate:up
+CREATE TABLE public.audit_events (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL,
+    actor VARCHAR(256) NOT NULL,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    before JSONB,
+    after JSONB
+);
+
+CREATE INDEX audit_events_hotel_created_at_idx ON public.audit_events (hotel_id, created_at, id);
+
+-- migrate:down
+DROP TABLE public.audit_events;
@@ -0,0 +1,19 @@
+-- migrate:up
+CREATE TABLE public.outbox (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    event_type VARCHAR(64) NOT NULL,
+    hotel_id UUID NOT NULL,
+    aggregate_type VARCHAR(32) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX outbox_pending_idx ON public.outbox (available_at, created_at) WHERE published_at IS NULL;
+
+-- migrate:down
+DROP TABLE public.outbox;
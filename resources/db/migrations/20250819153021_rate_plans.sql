@@ -0,0 +1,56 @@
+-- migrate:up
+CREATE TABLE public.rate_plans (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    name VARCHAR(128) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL,
+    CONSTRAINT rate_plans_hotel_name_key UNIQUE (hotel_id, name)
+);
+
+-- Nightly price of a room type under the plan, room types without one are
+-- sold at their base price
+CREATE TABLE public.rate_plan_prices (
+    rate_plan_id UUID NOT NULL REFERENCES rate_plans(id) ON DELETE CASCADE,
+    room_type_id UUID NOT NULL REFERENCES room_types(id),
+    price DECIMAL(10, 4) NOT NULL,
+    PRIMARY KEY (rate_plan_id, room_type_id),
+    CONSTRAINT rate_plan_prices_price_check CHECK (price >= 0)
+);
+
+CREATE TABLE public.rate_plan_seasons (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    rate_plan_id UUID NOT NULL REFERENCES rate_plans(id) ON DELETE CASCADE,
+    room_type_id UUID NOT NULL REFERENCES room_types(id),
+    name VARCHAR(128) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    price DECIMAL(10, 4) NOT NULL,
+    CONSTRAINT rate_plan_seasons_dates_check CHECK (end_date >= start_date),
+    CONSTRAINT rate_plan_seasons_price_check CHECK (price >= 0),
+    -- A night of a room type is priced by at most one season of the plan
+    CONSTRAINT rate_plan_seasons_overlap_excl EXCLUDE USING gist (
+        rate_plan_id WITH =,
+        room_type_id WITH =,
+        daterange(start_date, end_date, '[]') WITH &&
+    )
+);
+
+-- Percentage applied to the nightly price on a day of the week, 0 is Sunday
+CREATE TABLE public.rate_plan_day_modifiers (
+    rate_plan_id UUID NOT NULL REFERENCES rate_plans(id) ON DELETE CASCADE,
+    day_of_week SMALLINT NOT NULL,
+    percent DECIMAL(6, 2) NOT NULL,
+    PRIMARY KEY (rate_plan_id, day_of_week),
+    CONSTRAINT rate_plan_day_modifiers_day_check CHECK (day_of_week BETWEEN 0 AND 6),
+    CONSTRAINT rate_plan_day_modifiers_percent_check CHECK (percent > -100)
+);
+
+-- migrate:down
+DROP TABLE public.rate_plan_day_modifiers;
+DROP TABLE public.rate_plan_seasons;
+DROP TABLE public.rate_plan_prices;
+DROP TABLE public.rate_plans;
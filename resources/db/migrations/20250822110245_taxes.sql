@@ -0,0 +1,22 @@
+-- migrate:up
+-- Taxes and fees charged on stays, either by a single hotel or by every hotel
+-- of a country
+CREATE TABLE public.taxes (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID REFERENCES hotels(id),
+    country VARCHAR(64),
+    name VARCHAR(128) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    amount DECIMAL(10, 4) NOT NULL,
+    per_guest BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT taxes_scope_check CHECK ((hotel_id IS NULL) <> (country IS NULL)),
+    CONSTRAINT taxes_kind_check CHECK (kind IN ('percentage', 'per_night')),
+    CONSTRAINT taxes_amount_check CHECK (amount >= 0)
+);
+
+CREATE INDEX taxes_hotel_id_idx ON public.taxes (hotel_id);
+CREATE INDEX taxes_country_idx ON public.taxes (lower(country)) WHERE hotel_id IS NULL;
+
+-- migrate:down
+DROP TABLE public.taxes;
@@ -0,0 +1,24 @@
+-- migrate:up
+-- Existing hotels were priced in US dollars
+ALTER TABLE public.hotels ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE public.hotels ALTER COLUMN currency DROP DEFAULT;
+
+ALTER TABLE public.room_types ADD COLUMN currency CHAR(3);
+UPDATE public.room_types rt SET currency = h.currency FROM public.hotels h WHERE h.id = rt.hotel_id;
+ALTER TABLE public.room_types ALTER COLUMN currency SET NOT NULL;
+
+-- Amount of to_currency bought by one unit of from_currency
+CREATE TABLE public.exchange_rates (
+    from_currency CHAR(3) NOT NULL,
+    to_currency CHAR(3) NOT NULL,
+    rate DECIMAL(18, 8) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (from_currency, to_currency),
+    CONSTRAINT exchange_rates_pair_check CHECK (from_currency <> to_currency),
+    CONSTRAINT exchange_rates_rate_check CHECK (rate > 0)
+);
+
+-- migrate:down
+DROP TABLE public.exchange_rates;
+ALTER TABLE public.room_types DROP COLUMN currency;
+ALTER TABLE public.hotels DROP COLUMN currency;
@@ -0,0 +1,28 @@
+-- migrate:up
+-- Sales controls of a room type per night. Nights without a row sell every
+-- room of the type without restrictions, rooms sold are counted from the
+-- reservations.
+CREATE TABLE public.room_type_inventory (
+    room_type_id UUID NOT NULL REFERENCES room_types(id),
+    date DATE NOT NULL,
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    total_rooms INT NOT NULL,
+    blocked INT NOT NULL DEFAULT 0,
+    stop_sell BOOLEAN NOT NULL DEFAULT FALSE,
+    -- 0 means the length of stay is not restricted
+    min_stay INT NOT NULL DEFAULT 0,
+    max_stay INT NOT NULL DEFAULT 0,
+    closed_to_arrival BOOLEAN NOT NULL DEFAULT FALSE,
+    closed_to_departure BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (room_type_id, date),
+    CONSTRAINT room_type_inventory_rooms_check CHECK (total_rooms >= 0 AND blocked >= 0),
+    CONSTRAINT room_type_inventory_stay_check CHECK (
+        min_stay >= 0 AND max_stay >= 0 AND (max_stay = 0 OR max_stay >= min_stay)
+    )
+);
+
+CREATE INDEX room_type_inventory_hotel_date_idx ON public.room_type_inventory (hotel_id, date);
+
+-- migrate:down
+DROP TABLE public.room_type_inventory;
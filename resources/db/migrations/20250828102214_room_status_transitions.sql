@@ -0,0 +1,29 @@
+-- migrate:up
+-- Statuses outside of the state machine are taken out of service so they are
+-- reviewed before the rooms are sold again
+UPDATE public.rooms SET status = lower(status)
+WHERE lower(status) IN ('available', 'occupied', 'dirty', 'cleaning', 'inspected', 'out_of_order', 'out_of_service');
+UPDATE public.rooms SET status = 'out_of_service'
+WHERE status NOT IN ('available', 'occupied', 'dirty', 'cleaning', 'inspected', 'out_of_order', 'out_of_service');
+
+ALTER TABLE public.rooms ADD CONSTRAINT rooms_status_check CHECK (
+    status IN ('available', 'occupied', 'dirty', 'cleaning', 'inspected', 'out_of_order', 'out_of_service')
+);
+
+CREATE TABLE public.room_status_transitions (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    from_status VARCHAR(32) NOT NULL,
+    to_status VARCHAR(32) NOT NULL,
+    actor VARCHAR(256) NOT NULL,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX room_status_transitions_room_created_idx ON public.room_status_transitions (room_id, created_at DESC);
+
+-- migrate:down
+DROP TABLE public.room_status_transitions;
+ALTER TABLE public.rooms DROP CONSTRAINT rooms_status_check;
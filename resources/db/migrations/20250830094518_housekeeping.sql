@@ -0,0 +1,30 @@
+-- migrate:up
+CREATE TABLE public.housekeeping_frequencies (
+    room_type_id UUID NOT NULL PRIMARY KEY REFERENCES room_types(id),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    stayover_every_days INTEGER NOT NULL DEFAULT 1 CHECK (stayover_every_days >= 0)
+);
+
+CREATE INDEX housekeeping_frequencies_hotel_idx ON public.housekeeping_frequencies (hotel_id);
+
+CREATE TABLE public.housekeeping_tasks (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    date DATE NOT NULL,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('departure', 'stayover')),
+    status VARCHAR(32) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'in_progress', 'completed')),
+    assignee VARCHAR(256) NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    UNIQUE (room_id, date)
+);
+
+CREATE INDEX housekeeping_tasks_hotel_date_idx ON public.housekeeping_tasks (hotel_id, date);
+
+-- migrate:down
+DROP TABLE public.housekeeping_tasks;
+DROP TABLE public.housekeeping_frequencies;
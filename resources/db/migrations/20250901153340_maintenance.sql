@@ -0,0 +1,42 @@
+-- migrate:up
+CREATE TABLE public.maintenance_tickets (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    category VARCHAR(32) NOT NULL,
+    severity VARCHAR(16) NOT NULL CHECK (severity IN ('low', 'medium', 'high', 'critical')),
+    description TEXT NOT NULL,
+    reporter VARCHAR(256) NOT NULL,
+    assignee VARCHAR(256) NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'in_progress', 'resolved', 'closed')),
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX maintenance_tickets_room_created_idx ON public.maintenance_tickets (room_id, created_at DESC);
+
+-- Nights rooms are out of order, from start_date until the night before
+-- end_date. They are neither available nor sellable.
+CREATE TABLE public.room_blocks (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    ticket_id UUID REFERENCES maintenance_tickets(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(256) NOT NULL,
+    CONSTRAINT room_blocks_dates_check CHECK (end_date > start_date),
+    CONSTRAINT room_blocks_room_dates_excl EXCLUDE USING gist (
+        room_id WITH =,
+        daterange(start_date, end_date, '[)') WITH &&
+    )
+);
+
+CREATE INDEX room_blocks_hotel_dates_idx ON public.room_blocks (hotel_id, start_date, end_date);
+
+-- migrate:down
+DROP TABLE public.room_blocks;
+DROP TABLE public.maintenance_tickets;
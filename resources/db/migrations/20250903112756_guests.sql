@@ -0,0 +1,27 @@
+-- migrate:up
+-- Personal data of guests is encrypted in pii. email_index, phone_index and
+-- name_index are keyed hashes used to deduplicate and search guests.
+CREATE TABLE public.guests (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    nationality VARCHAR(2) NOT NULL DEFAULT '',
+    preferences JSONB,
+    marketing_consent BOOLEAN NOT NULL DEFAULT FALSE,
+    marketing_consent_at TIMESTAMPTZ,
+    merged_into UUID REFERENCES guests(id),
+    pii BYTEA NOT NULL,
+    email_index BYTEA,
+    phone_index BYTEA,
+    name_index TEXT[] NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX guests_hotel_email_idx ON public.guests (hotel_id, email_index)
+WHERE email_index IS NOT NULL AND merged_into IS NULL;
+CREATE INDEX guests_hotel_phone_idx ON public.guests (hotel_id, phone_index);
+CREATE INDEX guests_name_index_idx ON public.guests USING gin (name_index);
+CREATE INDEX guests_merged_into_idx ON public.guests (merged_into);
+
+-- migrate:down
+DROP TABLE public.guests;
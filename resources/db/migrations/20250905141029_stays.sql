@@ -0,0 +1,38 @@
+-- migrate:up
+-- A room has at most one stay in house, enforced by stays_room_in_house_idx.
+CREATE TABLE public.stays (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    reservation_id UUID REFERENCES reservations(id),
+    guest_id UUID REFERENCES guests(id),
+    guest_name VARCHAR(128) NOT NULL,
+    guests INTEGER NOT NULL CHECK (guests > 0),
+    expected_check_out DATE NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('in_house', 'checked_out')),
+    checked_in_at TIMESTAMPTZ NOT NULL,
+    checked_in_by VARCHAR(255) NOT NULL,
+    checked_out_at TIMESTAMPTZ,
+    checked_out_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX stays_room_in_house_idx ON public.stays (room_id) WHERE status = 'in_house';
+CREATE INDEX stays_hotel_status_idx ON public.stays (hotel_id, status);
+
+CREATE TABLE public.stay_room_moves (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    stay_id UUID NOT NULL REFERENCES stays(id) ON DELETE CASCADE,
+    from_room_id UUID NOT NULL REFERENCES rooms(id),
+    to_room_id UUID NOT NULL REFERENCES rooms(id),
+    actor VARCHAR(255) NOT NULL,
+    reason VARCHAR(512) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX stay_room_moves_stay_idx ON public.stay_room_moves (stay_id, created_at);
+
+-- migrate:down
+DROP TABLE public.stay_room_moves;
+DROP TABLE public.stays;
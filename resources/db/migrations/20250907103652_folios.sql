@@ -0,0 +1,45 @@
+-- migrate:up
+CREATE TABLE public.folios (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hotel_id UUID NOT NULL REFERENCES hotels(id),
+    room_id UUID NOT NULL REFERENCES rooms(id),
+    guest_name VARCHAR(128) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('open', 'closed')),
+    opened_by VARCHAR(255) NOT NULL,
+    closed_at TIMESTAMPTZ,
+    closed_by VARCHAR(255) NOT NULL DEFAULT '',
+    CONSTRAINT folios_dates_check CHECK (end_date > start_date)
+);
+
+CREATE INDEX folios_hotel_created_at_idx ON public.folios (hotel_id, created_at);
+CREATE INDEX folios_room_idx ON public.folios (room_id);
+
+-- Entries are never updated nor deleted, amounts are positive and their kind
+-- tells whether they are charged to or credited on the folio
+CREATE TABLE public.folio_entries (
+    id UUID NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    folio_id UUID NOT NULL REFERENCES folios(id),
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('room_charge', 'charge', 'payment', 'refund')),
+    category VARCHAR(32) NOT NULL,
+    description VARCHAR(256) NOT NULL,
+    date DATE NOT NULL,
+    amount DECIMAL(12, 4) NOT NULL,
+    reference VARCHAR(128) NOT NULL DEFAULT '',
+    posted_by VARCHAR(255) NOT NULL,
+    CONSTRAINT folio_entries_amount_check CHECK (amount > 0)
+);
+
+CREATE INDEX folio_entries_folio_idx ON public.folio_entries (folio_id, created_at);
+-- A night is charged once per folio
+CREATE UNIQUE INDEX folio_entries_room_charge_idx ON public.folio_entries (folio_id, date)
+WHERE kind = 'room_charge';
+
+-- migrate:down
+DROP TABLE public.folio_entries;
+DROP TABLE public.folios;
@@ -0,0 +1,16 @@
+-- migrate:up
+-- Relays lease the messages they publish until locked_until instead of
+-- holding a transaction open, messages that exhausted their attempts are dead
+ALTER TABLE public.outbox ADD COLUMN locked_until TIMESTAMPTZ;
+ALTER TABLE public.outbox ADD COLUMN dead_at TIMESTAMPTZ;
+
+DROP INDEX public.outbox_pending_idx;
+CREATE INDEX outbox_pending_idx ON public.outbox (available_at, created_at)
+WHERE published_at IS NULL AND dead_at IS NULL;
+
+-- migrate:down
+DROP INDEX public.outbox_pending_idx;
+CREATE INDEX outbox_pending_idx ON public.outbox (available_at, created_at) WHERE published_at IS NULL;
+
+ALTER TABLE public.outbox DROP COLUMN dead_at;
+ALTER TABLE public.outbox DROP COLUMN locked_until;
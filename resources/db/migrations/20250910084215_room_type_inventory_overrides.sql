@@ -0,0 +1,19 @@
+-- migrate:up
+-- Nights store the total rooms only when they are overridden, the others
+-- follow the rooms added to or removed from the room type. Stored totals
+-- matching the current rooms of the type were copied when another control of
+-- the night was updated, they are no longer frozen.
+ALTER TABLE public.room_type_inventory ALTER COLUMN total_rooms DROP NOT NULL;
+
+UPDATE public.room_type_inventory AS inv SET total_rooms = NULL
+WHERE inv.total_rooms = (
+    SELECT COUNT(*) FROM public.rooms AS r WHERE r.room_type_id = inv.room_type_id AND r.deleted_at IS NULL
+);
+
+-- migrate:down
+UPDATE public.room_type_inventory AS inv SET total_rooms = (
+    SELECT COUNT(*) FROM public.rooms AS r WHERE r.room_type_id = inv.room_type_id AND r.deleted_at IS NULL
+)
+WHERE inv.total_rooms IS NULL;
+
+ALTER TABLE public.room_type_inventory ALTER COLUMN total_rooms SET NOT NULL;
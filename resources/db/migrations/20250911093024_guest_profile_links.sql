@@ -0,0 +1,35 @@
+-- migrate:up
+-- Reservations, stays and folios are linked to guest profiles, which keep the
+-- personal data of guests encrypted. The names and emails stored in clear
+-- text before are moved into guest profiles and cleared by
+-- cmd/migrate-guests, they can not be encrypted here without the guest
+-- encryption key.
+ALTER TABLE public.reservations ADD COLUMN guest_id UUID REFERENCES guests(id) ON DELETE SET NULL;
+ALTER TABLE public.reservations ALTER COLUMN guest_name DROP NOT NULL;
+ALTER TABLE public.reservations ALTER COLUMN guest_email DROP NOT NULL;
+CREATE INDEX reservations_guest_idx ON public.reservations (guest_id);
+
+ALTER TABLE public.stays ALTER COLUMN guest_name DROP NOT NULL;
+ALTER TABLE public.stays DROP CONSTRAINT stays_guest_id_fkey;
+ALTER TABLE public.stays ADD CONSTRAINT stays_guest_id_fkey
+    FOREIGN KEY (guest_id) REFERENCES guests(id) ON DELETE SET NULL;
+
+ALTER TABLE public.folios ADD COLUMN guest_id UUID REFERENCES guests(id) ON DELETE SET NULL;
+ALTER TABLE public.folios ALTER COLUMN guest_name DROP NOT NULL;
+
+-- migrate:down
+-- The names and emails moved into guest profiles are not restored
+UPDATE public.folios SET guest_name = '' WHERE guest_name IS NULL;
+ALTER TABLE public.folios ALTER COLUMN guest_name SET NOT NULL;
+ALTER TABLE public.folios DROP COLUMN guest_id;
+
+ALTER TABLE public.stays DROP CONSTRAINT stays_guest_id_fkey;
+ALTER TABLE public.stays ADD CONSTRAINT stays_guest_id_fkey FOREIGN KEY (guest_id) REFERENCES guests(id);
+UPDATE public.stays SET guest_name = '' WHERE guest_name IS NULL;
+ALTER TABLE public.stays ALTER COLUMN guest_name SET NOT NULL;
+
+DROP INDEX public.reservations_guest_idx;
+UPDATE public.reservations SET guest_name = '', guest_email = '' WHERE guest_name IS NULL;
+ALTER TABLE public.reservations ALTER COLUMN guest_email SET NOT NULL;
+ALTER TABLE public.reservations ALTER COLUMN guest_name SET NOT NULL;
+ALTER TABLE public.reservations DROP COLUMN guest_id;
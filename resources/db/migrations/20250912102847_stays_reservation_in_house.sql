@@ -0,0 +1,8 @@
+-- migrate:up
+-- A reservation has at most one stay in house, so concurrent check-ins of
+-- the same reservation can not both succeed.
+CREATE UNIQUE INDEX stays_reservation_in_house_idx ON public.stays (reservation_id)
+WHERE status = 'in_house' AND reservation_id IS NOT NULL;
+
+-- migrate:down
+DROP INDEX public.stays_reservation_in_house_idx;